@@ -10,6 +10,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services"
 	aad "github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/client"
+	msgraph "github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/client"
 )
 
 type ClientBuilder struct {
@@ -68,6 +69,14 @@ func (b *ClientBuilder) Build(ctx context.Context) (*AadClient, error) {
 
 	client.AadGraph = aad.BuildClient(o, graphEndpoint, graphAuthorizer)
 
+	msGraphEndpoint := msgraph.Endpoint(*env)
+	msGraphAuthorizer, err := b.AuthConfig.GetAuthorizationToken(sender, oauth, msGraphEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	client.MsGraph = msgraph.BuildClient(o, msGraphEndpoint, msGraphAuthorizer)
+
 	autorest.Count429AsRetry = false
 
 	client.StopContext = ctx
@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 
 	aad "github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/client"
+	msgraph "github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/client"
 )
 
 // AadClient contains the handles to all the specific Azure AD resource classes' respective clients
@@ -24,4 +25,5 @@ type AadClient struct {
 
 	// Azure AD clients
 	AadGraph *aad.Client
+	MsGraph  *msgraph.Client
 }
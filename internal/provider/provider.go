@@ -11,6 +11,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph"
 )
 
 type ServiceRegistration interface {
@@ -46,6 +47,7 @@ func AzureADProvider() terraform.ResourceProvider {
 	// looks like only an env var will work?
 	services := []ServiceRegistration{
 		aadgraph.Registration{},
+		msgraph.Registration{},
 	}
 
 	dataSources := make(map[string]*schema.Resource)
@@ -21,14 +21,17 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_application":       applicationData(),
-		"azuread_domains":           domainsData(),
-		"azuread_client_config":     clientConfigData(),
-		"azuread_group":             groupData(),
-		"azuread_groups":            groupsData(),
-		"azuread_service_principal": servicePrincipalData(),
-		"azuread_user":              userData(),
-		"azuread_users":             usersData(),
+		"azuread_application":              applicationData(),
+		"azuread_domains":                  domainsData(),
+		"azuread_client_config":            clientConfigData(),
+		"azuread_group":                    groupData(),
+		"azuread_group_members":            groupMembersData(),
+		"azuread_groups":                   groupsData(),
+		"azuread_oauth2_permission_grants": oauth2PermissionGrantsData(),
+		"azuread_service_principal":        servicePrincipalData(),
+		"azuread_user":                     userData(),
+		"azuread_user_group_memberships":   userGroupMembershipsData(),
+		"azuread_users":                    usersData(),
 	}
 }
 
@@ -39,6 +42,7 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azuread_application_app_role":          applicationAppRoleResource(),
 		"azuread_application_certificate":       applicationCertificateResource(),
 		"azuread_application_oauth2_permission": applicationOAuth2PermissionResource(),
+		"azuread_application_owner":             applicationOwnerResource(),
 		"azuread_application_password":          applicationPasswordResource(),
 		"azuread_group":                         groupResource(),
 		"azuread_group_member":                  groupMemberResource(),
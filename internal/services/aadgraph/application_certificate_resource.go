@@ -15,9 +15,23 @@ import (
 )
 
 func applicationCertificateResource() *schema.Resource {
+	certificateSchema := graph.CertificateResourceSchema("application_object_id")
+
+	// the validity dates can be patched in place, so changing them shouldn't recreate the
+	// credential (which would break consumers keyed on the key ID)
+	for _, attr := range []string{"start_date", "end_date", "end_date_relative"} {
+		certificateSchema[attr].ForceNew = false
+	}
+
+	certificateSchema["thumbprint"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
 	return &schema.Resource{
 		Create: applicationCertificateResourceCreate,
 		Read:   applicationCertificateResourceRead,
+		Update: applicationCertificateResourceUpdate,
 		Delete: applicationCertificateResourceDelete,
 
 		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
@@ -25,7 +39,10 @@ func applicationCertificateResource() *schema.Resource {
 			return err
 		}),
 
-		Schema: graph.CertificateResourceSchema("application_object_id"),
+		// catch values which don't parse as a certificate at plan time, rather than at apply
+		CustomizeDiff: graph.ValidateCertificateDiff,
+
+		Schema: certificateSchema,
 	}
 }
 
@@ -73,6 +90,40 @@ func applicationCertificateResourceCreate(d *schema.ResourceData, meta interface
 	return applicationCertificateResourceRead(d, meta)
 }
 
+func applicationCertificateResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).AadGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseCertificateId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing certificate credential with ID: %v", err)
+	}
+
+	startDate, endDate, err := graph.KeyCredentialDatesFromResource(d)
+	if err != nil {
+		return fmt.Errorf("determining new dates for certificate credential %q for application with object ID %q: %+v", id.KeyId, id.ObjectId, err)
+	}
+
+	tf.LockByName(resourceApplicationName, id.ObjectId)
+	defer tf.UnlockByName(resourceApplicationName, id.ObjectId)
+
+	existingCreds, err := client.ListKeyCredentials(ctx, id.ObjectId)
+	if err != nil {
+		return fmt.Errorf("listing certificate credentials for application with object ID %q: %+v", id.ObjectId, err)
+	}
+
+	newCreds, err := graph.KeyCredentialResultUpdateDates(existingCreds, id.KeyId, startDate, endDate)
+	if err != nil {
+		return fmt.Errorf("updating Application Certificate: %+v", err)
+	}
+
+	if _, err = client.UpdateKeyCredentials(ctx, id.ObjectId, graphrbac.KeyCredentialsUpdateParameters{Value: newCreds}); err != nil {
+		return fmt.Errorf("updating certificate credentials %q for application with object ID %q: %+v", id.KeyId, id.ObjectId, err)
+	}
+
+	return applicationCertificateResourceRead(d, meta)
+}
+
 func applicationCertificateResourceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.AadClient).AadGraph.ApplicationsClient
 	ctx := meta.(*clients.AadClient).StopContext
@@ -121,6 +172,10 @@ func applicationCertificateResourceRead(d *schema.ResourceData, meta interface{}
 		d.Set("start_date", startDate.Format(time.RFC3339))
 	}
 
+	if thumbprint := credential.CustomKeyIdentifier; thumbprint != nil {
+		d.Set("thumbprint", thumbprint)
+	}
+
 	return nil
 }
 
@@ -167,6 +167,37 @@ func TestAccApplicationCertificate_relativeEndDate(t *testing.T) {
 	})
 }
 
+func TestAccApplicationCertificate_updateEndDate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	keyType := "AsymmetricX509Cert"
+	endDate := time.Now().AddDate(0, 6, 0).UTC().Format(time.RFC3339)
+	updatedEndDate := time.Now().AddDate(0, 9, 0).UTC().Format(time.RFC3339)
+	value := testCertificateApplication
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccADObjectCertificateApplication_basic(data.RandomInteger, keyType, endDate, value),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationKeyExists(data.ResourceName),
+				),
+			},
+			{
+				Config: testAccADObjectCertificateApplication_basic(data.RandomInteger, keyType, updatedEndDate, value),
+				Check: resource.ComposeTestCheckFunc(
+					// the key ID should survive the date change since the credential is patched in place
+					testCheckApplicationKeyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "end_date", updatedEndDate),
+				),
+			},
+			data.ImportStep("end_date_relative", "value"),
+		},
+	})
+}
+
 func TestAccApplicationCertificate_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
 	keyType := "AsymmetricX509Cert"
@@ -22,7 +22,7 @@ func applicationData() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"application_id", "name", "object_id"},
+				ExactlyOneOf: []string{"application_id", "identifier_uri", "name", "object_id"},
 				ValidateFunc: validate.UUID,
 			},
 
@@ -30,15 +30,23 @@ func applicationData() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"application_id", "name", "object_id"},
+				ExactlyOneOf: []string{"application_id", "identifier_uri", "name", "object_id"},
 				ValidateFunc: validate.UUID,
 			},
 
+			"identifier_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"application_id", "identifier_uri", "name", "object_id"},
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"application_id", "name", "object_id"},
+				ExactlyOneOf: []string{"application_id", "identifier_uri", "name", "object_id"},
 				ValidateFunc: validate.NoEmptyStrings,
 			},
 
@@ -98,8 +106,7 @@ func applicationData() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"access_token": graph.SchemaOptionalClaims(),
 						"id_token":     graph.SchemaOptionalClaims(),
-						// TODO: enable when https://github.com/Azure/azure-sdk-for-go/issues/9714 resolved
-						//"saml_token": graph.SchemaOptionalClaims(),
+						"saml2_token":  graph.SchemaOptionalClaims(),
 					},
 				},
 			},
@@ -166,19 +173,23 @@ func applicationDataRead(d *schema.ResourceData, meta interface{}) error {
 
 		app = resp
 	} else {
-		var fieldName, fieldValue string
+		var fieldName, fieldValue, filter string
 		if applicationId, ok := d.Get("application_id").(string); ok && applicationId != "" {
 			fieldName = "appId"
 			fieldValue = applicationId
+			filter = fmt.Sprintf("%s eq '%s'", fieldName, fieldValue)
+		} else if identifierUri, ok := d.Get("identifier_uri").(string); ok && identifierUri != "" {
+			fieldName = "identifierUris"
+			fieldValue = identifierUri
+			filter = fmt.Sprintf("identifierUris/any(uri:uri eq '%s')", fieldValue)
 		} else if name, ok := d.Get("name").(string); ok && name != "" {
 			fieldName = "displayName"
 			fieldValue = name
+			filter = fmt.Sprintf("%s eq '%s'", fieldName, fieldValue)
 		} else {
-			return fmt.Errorf("one of `object_id` or `name` must be supplied")
+			return fmt.Errorf("one of `object_id`, `application_id`, `identifier_uri` or `name` must be supplied")
 		}
 
-		filter := fmt.Sprintf("%s eq '%s'", fieldName, fieldValue)
-
 		resp, err := client.ListComplete(ctx, filter)
 		if err != nil {
 			return fmt.Errorf("listing Applications for filter %q: %+v", filter, err)
@@ -211,6 +222,20 @@ func applicationDataRead(d *schema.ResourceData, meta interface{}) error {
 			if *app.DisplayName != fieldValue {
 				return fmt.Errorf("DisplayName for Applications matching %q does not match(%q!=%q)", filter, *app.DisplayName, fieldValue)
 			}
+		case "identifierUris":
+			if app.IdentifierUris == nil {
+				return fmt.Errorf("bad API response: nil IdentifierUris for Applications matching %q", filter)
+			}
+			found := false
+			for _, uri := range *app.IdentifierUris {
+				if uri == fieldValue {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("IdentifierUris for Applications matching %q does not contain %q", filter, fieldValue)
+			}
 		}
 	}
 
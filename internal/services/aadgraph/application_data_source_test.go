@@ -105,6 +105,28 @@ func TestAccApplicationDataSource_byApplicationId(t *testing.T) {
 	})
 }
 
+func TestAccApplicationDataSource_byIdentifierUri(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationDataSource_identifierUri(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "name", fmt.Sprintf("acctest-APP-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "identifier_uris.#", "1"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "application_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccApplicationDataSource_byName(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_application", "test")
 
@@ -167,6 +189,19 @@ data "azuread_application" "test" {
 `, template)
 }
 
+func testAccApplicationDataSource_identifierUri(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name            = "acctest-APP-%[1]d"
+  identifier_uris = ["http://%[1]d.hashicorptest.com/datasource"]
+}
+
+data "azuread_application" "test" {
+  identifier_uri = azuread_application.test.identifier_uris[0]
+}
+`, ri)
+}
+
 func testAccApplicationDataSource_name(ri int) string {
 	template := testAccApplication_basic(ri)
 	return fmt.Sprintf(`
@@ -0,0 +1,129 @@
+package aadgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+const applicationOwnerResourceName = "azuread_application_owner"
+
+func applicationOwnerResource() *schema.Resource {
+	return &schema.Resource{
+		Create: applicationOwnerResourceCreate,
+		Read:   applicationOwnerResourceRead,
+		Delete: applicationOwnerResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseApplicationOwnerId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"owner_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func applicationOwnerResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).AadGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	objectId := d.Get("application_object_id").(string)
+	ownerId := d.Get("owner_object_id").(string)
+
+	id := graph.ApplicationOwnerIdFrom(objectId, ownerId)
+
+	tf.LockByName(applicationOwnerResourceName, objectId)
+	defer tf.UnlockByName(applicationOwnerResourceName, objectId)
+
+	existingOwners, err := graph.ApplicationAllOwners(ctx, client, objectId)
+	if err != nil {
+		return fmt.Errorf("listing existing owners for Application with ID %q: %+v", objectId, err)
+	}
+	for _, v := range existingOwners {
+		if strings.EqualFold(v, ownerId) {
+			return tf.ImportAsExistsError("azuread_application_owner", id.String())
+		}
+	}
+
+	if err := graph.ApplicationAddOwner(ctx, client, objectId, ownerId); err != nil {
+		return err
+	}
+
+	d.SetId(id.String())
+	return applicationOwnerResourceRead(d, meta)
+}
+
+func applicationOwnerResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).AadGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseApplicationOwnerId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	owners, err := graph.ApplicationAllOwners(ctx, client, id.ObjectId)
+	if err != nil {
+		return fmt.Errorf("retrieving owners for Application with ID %q: %+v", id.ObjectId, err)
+	}
+
+	var ownerObjectId string
+	for _, objectId := range owners {
+		if strings.EqualFold(objectId, id.OwnerId) {
+			ownerObjectId = objectId
+			break
+		}
+	}
+
+	if ownerObjectId == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_object_id", id.ObjectId)
+	d.Set("owner_object_id", ownerObjectId)
+
+	return nil
+}
+
+func applicationOwnerResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).AadGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseApplicationOwnerId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	tf.LockByName(applicationOwnerResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationOwnerResourceName, id.ObjectId)
+
+	if resp, err := client.RemoveOwner(ctx, id.ObjectId, id.OwnerId); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("removing owner %q from Application with ID %q: %+v", id.OwnerId, id.ObjectId, err)
+		}
+	}
+
+	return nil
+}
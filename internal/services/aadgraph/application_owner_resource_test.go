@@ -0,0 +1,128 @@
+package aadgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+func TestAccApplicationOwner_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_owner", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationOwnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationOwner_user(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "application_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "owner_object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccApplicationOwner_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_owner", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationOwnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationOwner_servicePrincipal(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "application_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "owner_object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckApplicationOwnerDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_application_owner" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).AadGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		objectId := rs.Primary.Attributes["application_object_id"]
+		ownerId := rs.Primary.Attributes["owner_object_id"]
+
+		// see if the application exists
+		if resp, err := client.Get(ctx, objectId); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return fmt.Errorf("retrieving Application with ID %q: %+v", objectId, err)
+		}
+
+		owners, err := graph.ApplicationAllOwners(ctx, client, objectId)
+		if err != nil {
+			return fmt.Errorf("retrieving owners for Application with ID %q: %+v", objectId, err)
+		}
+
+		for _, owner := range owners {
+			if owner == ownerId {
+				return fmt.Errorf("Application owner still exists:\n%#v", owner)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccApplicationOwner_user(id int, password string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  name = "acctestApp-%[2]d"
+}
+
+resource "azuread_application_owner" "test" {
+  application_object_id = azuread_application.test.object_id
+  owner_object_id       = azuread_user.test.object_id
+}
+`, testAccUser_basic(id, password), id)
+}
+
+func testAccApplicationOwner_servicePrincipal(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "owner" {
+  name = "acctestApp-owner-%[1]d"
+}
+
+resource "azuread_service_principal" "owner" {
+  application_id = azuread_application.owner.application_id
+}
+
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_application_owner" "test" {
+  application_object_id = azuread_application.test.object_id
+  owner_object_id       = azuread_service_principal.owner.object_id
+}
+`, id)
+}
@@ -2,8 +2,10 @@ package aadgraph
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/go-uuid"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/graph"
+	msgraph "github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
@@ -79,6 +82,20 @@ func applicationResource() *schema.Resource {
 				ValidateFunc: validate.URLIsHTTPOrHTTPS,
 			},
 
+			"fallback_public_client_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			// the logo is not returned by the API, so the configured image stays in state
+			// and changes are detected by comparing the base64 content
+			"logo_image": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
 			"oauth2_allow_implicit_flow": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -107,6 +124,91 @@ func applicationResource() *schema.Resource {
 				Default:      "webapp/api",
 			},
 
+			"api": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"known_client_applications": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.UUID,
+							},
+						},
+
+						"requested_access_token_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 2),
+						},
+
+						"oauth2_permission_scope": {
+							Type:       schema.TypeSet,
+							Optional:   true,
+							ConfigMode: schema.SchemaConfigModeAttr,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.UUID,
+									},
+
+									"admin_consent_description": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"admin_consent_display_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "User",
+										ValidateFunc: validation.StringInSlice([]string{
+											"Admin",
+											"User",
+										}, false),
+									},
+
+									"user_consent_description": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"user_consent_display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"app_role": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -114,8 +216,10 @@ func applicationResource() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.UUID,
 						},
 
 						"allowed_member_types": {
@@ -166,8 +270,7 @@ func applicationResource() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"access_token": graph.SchemaOptionalClaims(),
 						"id_token":     graph.SchemaOptionalClaims(),
-						// TODO: enable when https://github.com/Azure/azure-sdk-for-go/issues/9714 resolved
-						//"saml_token": graph.SchemaOptionalClaims(),
+						"saml2_token":  graph.SchemaOptionalClaims(),
 					},
 				},
 			},
@@ -555,9 +658,90 @@ func applicationResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// the api surface of the application is not exposed by AAD Graph, so it is managed via Microsoft Graph
+	if d.HasChange("api") {
+		if err := applicationSetApi(d, meta); err != nil {
+			return err
+		}
+	}
+
+	// the fallback public client setting is likewise only exposed by Microsoft Graph
+	if d.HasChange("fallback_public_client_enabled") {
+		msgraphClient := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+		properties := msgraph.Application{
+			IsFallbackPublicClient: utils.Bool(d.Get("fallback_public_client_enabled").(bool)),
+		}
+		if _, err := msgraphClient.Update(ctx, d.Id(), properties); err != nil {
+			return fmt.Errorf("setting `fallback_public_client_enabled` for Application with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("logo_image") {
+		if v, ok := d.GetOk("logo_image"); ok {
+			if err := applicationSetLogo(ctx, meta, d.Id(), v.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
 	return applicationResourceRead(d, meta)
 }
 
+// applicationSetLogo uploads the application logo, decoding it from base64 and detecting
+// the image content type from the decoded payload
+func applicationSetLogo(ctx context.Context, meta interface{}, applicationId, logoImage string) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+
+	data, err := base64.StdEncoding.DecodeString(logoImage)
+	if err != nil {
+		return fmt.Errorf("`logo_image` should be a base64-encoded image: %+v", err)
+	}
+
+	if _, err := client.SetLogo(ctx, applicationId, http.DetectContentType(data), data); err != nil {
+		return fmt.Errorf("uploading logo for Application with ID %q: %+v", applicationId, err)
+	}
+
+	return nil
+}
+
+func applicationSetApi(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	// if a permission scope already exists then it must be disabled
+	// with no other changes before it can be edited or deleted
+	app, _, err := client.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("retrieving Application with ID %q: %+v", d.Id(), err)
+	}
+
+	if app.Api != nil && app.Api.OAuth2PermissionScopes != nil && len(*app.Api.OAuth2PermissionScopes) > 0 {
+		for i := range *app.Api.OAuth2PermissionScopes {
+			(*app.Api.OAuth2PermissionScopes)[i].IsEnabled = utils.Bool(false)
+		}
+
+		properties := msgraph.Application{
+			Api: &msgraph.ApplicationApi{
+				OAuth2PermissionScopes: app.Api.OAuth2PermissionScopes,
+			},
+		}
+
+		if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+			return fmt.Errorf("disabling OAuth2 permission scopes for Application with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	properties := msgraph.Application{
+		Api: expandApplicationApi(d.Get("api").([]interface{})),
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("setting `api` for Application with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
 func applicationResourceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.AadClient).AadGraph.ApplicationsClient
 	ctx := meta.(*clients.AadClient).StopContext
@@ -616,6 +800,22 @@ func applicationResourceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `oauth2_permissions`: %+v", err)
 	}
 
+	// the api surface of the application is not exposed by AAD Graph, so it is read via Microsoft Graph
+	msgraphApp, _, err := meta.(*clients.AadClient).MsGraph.ApplicationsClient.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("retrieving API settings for Application with ID %q: %+v", d.Id(), err)
+	}
+
+	if err := d.Set("api", flattenApplicationApi(msgraphApp.Api)); err != nil {
+		return fmt.Errorf("setting `api`: %+v", err)
+	}
+
+	fallbackPublicClient := false
+	if msgraphApp.IsFallbackPublicClient != nil {
+		fallbackPublicClient = *msgraphApp.IsFallbackPublicClient
+	}
+	d.Set("fallback_public_client_enabled", fallbackPublicClient)
+
 	owners, err := graph.ApplicationAllOwners(ctx, client, d.Id())
 	if err != nil {
 		return fmt.Errorf("getting owners for Application %q: %+v", *app.ObjectID, err)
@@ -658,6 +858,117 @@ func applicationResourceDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func expandApplicationApi(in []interface{}) *msgraph.ApplicationApi {
+	result := msgraph.ApplicationApi{
+		KnownClientApplications:     &[]string{},
+		OAuth2PermissionScopes:      &[]msgraph.PermissionScope{},
+		RequestedAccessTokenVersion: utils.Int32(1),
+	}
+
+	if len(in) == 0 || in[0] == nil {
+		return &result
+	}
+
+	api := in[0].(map[string]interface{})
+	result.KnownClientApplications = tf.ExpandStringSlicePtr(api["known_client_applications"].(*schema.Set).List())
+	result.RequestedAccessTokenVersion = utils.Int32(int32(api["requested_access_token_version"].(int)))
+
+	scopes := make([]msgraph.PermissionScope, 0)
+	for _, raw := range api["oauth2_permission_scope"].(*schema.Set).List() {
+		scope := raw.(map[string]interface{})
+
+		newScope := msgraph.PermissionScope{
+			ID:        utils.String(scope["id"].(string)),
+			IsEnabled: utils.Bool(scope["enabled"].(bool)),
+			Type:      utils.String(scope["type"].(string)),
+		}
+
+		if v := scope["admin_consent_description"].(string); v != "" {
+			newScope.AdminConsentDescription = utils.String(v)
+		}
+		if v := scope["admin_consent_display_name"].(string); v != "" {
+			newScope.AdminConsentDisplayName = utils.String(v)
+		}
+		if v := scope["user_consent_description"].(string); v != "" {
+			newScope.UserConsentDescription = utils.String(v)
+		}
+		if v := scope["user_consent_display_name"].(string); v != "" {
+			newScope.UserConsentDisplayName = utils.String(v)
+		}
+		if v := scope["value"].(string); v != "" {
+			newScope.Value = utils.String(v)
+		}
+
+		scopes = append(scopes, newScope)
+	}
+	result.OAuth2PermissionScopes = &scopes
+
+	return &result
+}
+
+func flattenApplicationApi(in *msgraph.ApplicationApi) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	knownClientApplications := make([]string, 0)
+	if in.KnownClientApplications != nil {
+		knownClientApplications = *in.KnownClientApplications
+	}
+
+	requestedAccessTokenVersion := int32(1)
+	if in.RequestedAccessTokenVersion != nil {
+		requestedAccessTokenVersion = *in.RequestedAccessTokenVersion
+	}
+
+	scopes := make([]map[string]interface{}, 0)
+	if in.OAuth2PermissionScopes != nil {
+		for _, scope := range *in.OAuth2PermissionScopes {
+			newScope := map[string]interface{}{
+				"id":                         "",
+				"admin_consent_description":  "",
+				"admin_consent_display_name": "",
+				"enabled":                    false,
+				"type":                       "User",
+				"user_consent_description":   "",
+				"user_consent_display_name":  "",
+				"value":                      "",
+			}
+			if scope.ID != nil {
+				newScope["id"] = *scope.ID
+			}
+			if scope.AdminConsentDescription != nil {
+				newScope["admin_consent_description"] = *scope.AdminConsentDescription
+			}
+			if scope.AdminConsentDisplayName != nil {
+				newScope["admin_consent_display_name"] = *scope.AdminConsentDisplayName
+			}
+			if scope.IsEnabled != nil {
+				newScope["enabled"] = *scope.IsEnabled
+			}
+			if scope.Type != nil {
+				newScope["type"] = *scope.Type
+			}
+			if scope.UserConsentDescription != nil {
+				newScope["user_consent_description"] = *scope.UserConsentDescription
+			}
+			if scope.UserConsentDisplayName != nil {
+				newScope["user_consent_display_name"] = *scope.UserConsentDisplayName
+			}
+			if scope.Value != nil {
+				newScope["value"] = *scope.Value
+			}
+			scopes = append(scopes, newScope)
+		}
+	}
+
+	return []map[string]interface{}{{
+		"known_client_applications":      knownClientApplications,
+		"requested_access_token_version": int(requestedAccessTokenVersion),
+		"oauth2_permission_scope":        scopes,
+	}}
+}
+
 func expandApplicationRequiredResourceAccess(d *schema.ResourceData) *[]graphrbac.RequiredResourceAccess {
 	requiredResourcesAccesses := d.Get("required_resource_access").(*schema.Set).List()
 	result := make([]graphrbac.RequiredResourceAccess, 0)
@@ -744,8 +1055,7 @@ func expandApplicationOptionalClaims(d *schema.ResourceData) *graphrbac.Optional
 		optionalClaims := raw.(map[string]interface{})
 		result.AccessToken = expandApplicationOptionalClaim(optionalClaims["access_token"].([]interface{}))
 		result.IDToken = expandApplicationOptionalClaim(optionalClaims["id_token"].([]interface{}))
-		// TODO: enable when https://github.com/Azure/azure-sdk-for-go/issues/9714 resolved
-		//result.SamlToken = expandApplicationOptionalClaim(optionalClaims["saml_token"].([]interface{}))
+		result.SamlToken = expandApplicationOptionalClaim(optionalClaims["saml2_token"].([]interface{}))
 	}
 	return &result
 }
@@ -795,10 +1105,9 @@ func flattenApplicationOptionalClaims(in *graphrbac.OptionalClaims) interface{}
 	if claims := flattenApplicationOptionalClaimsList(in.IDToken); len(claims) > 0 {
 		optionalClaims["id_token"] = claims
 	}
-	// TODO: enable when https://github.com/Azure/azure-sdk-for-go/issues/9714 resolved
-	//if claims := flattenApplicationOptionalClaimsList(in.SamlToken); len(claims) > 0 {
-	//	optionalClaims["saml_token"] = claims
-	//}
+	if claims := flattenApplicationOptionalClaimsList(in.SamlToken); len(claims) > 0 {
+		optionalClaims["saml2_token"] = claims
+	}
 	if len(optionalClaims) == 0 {
 		return result
 	}
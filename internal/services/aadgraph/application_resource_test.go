@@ -65,6 +65,7 @@ func TestAccApplication_complete(t *testing.T) {
 					resource.TestCheckResourceAttr(data.ResourceName, "optional_claims.#", "1"),
 					resource.TestCheckResourceAttr(data.ResourceName, "optional_claims.0.access_token.#", "2"),
 					resource.TestCheckResourceAttr(data.ResourceName, "optional_claims.0.id_token.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "optional_claims.0.saml2_token.#", "1"),
 					resource.TestCheckResourceAttr(data.ResourceName, "required_resource_access.#", "2"),
 					resource.TestCheckResourceAttr(data.ResourceName, "oauth2_permissions.#", "2"),
 					resource.TestCheckResourceAttrSet(data.ResourceName, "application_id"),
@@ -110,6 +111,7 @@ func TestAccApplication_update(t *testing.T) {
 					resource.TestCheckResourceAttr(data.ResourceName, "optional_claims.#", "1"),
 					resource.TestCheckResourceAttr(data.ResourceName, "optional_claims.0.access_token.#", "2"),
 					resource.TestCheckResourceAttr(data.ResourceName, "optional_claims.0.id_token.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "optional_claims.0.saml2_token.#", "1"),
 					resource.TestCheckResourceAttr(data.ResourceName, "required_resource_access.#", "2"),
 					resource.TestCheckResourceAttr(data.ResourceName, "oauth2_permissions.#", "2"),
 				),
@@ -197,6 +199,48 @@ func TestAccApplication_availableToOtherTenants(t *testing.T) {
 	})
 }
 
+func TestAccApplication_api(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplication_api(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "api.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "api.0.requested_access_token_version", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "api.0.oauth2_permission_scope.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccApplication_fallbackPublicClient(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplication_fallbackPublicClient(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "fallback_public_client_enabled", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
 func TestAccApplication_appRoles(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 
@@ -217,6 +261,26 @@ func TestAccApplication_appRoles(t *testing.T) {
 	})
 }
 
+func TestAccApplication_appRolesCustomId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplication_appRolesCustomId(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "app_role.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
 func TestAccApplication_appRolesNoValue(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 
@@ -723,6 +787,10 @@ resource "azuread_application" "test" {
       essential             = true
       additional_properties = ["emit_as_roles"]
     }
+
+    saml2_token {
+      name = "samlexample"
+    }
   }
 
   owners = [azuread_user.test.object_id]
@@ -730,6 +798,37 @@ resource "azuread_application" "test" {
 `, testAccUser_basic(ri, pw), ri)
 }
 
+func testAccApplication_api(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctest-APP-%[1]d"
+
+  api {
+    requested_access_token_version = 2
+
+    oauth2_permission_scope {
+      id                         = "9e382b9b-3aa9-4dbe-948e-99cb25f64576"
+      admin_consent_description  = "Administer the application"
+      admin_consent_display_name = "Administer"
+      enabled                    = true
+      type                       = "Admin"
+      value                      = "administer"
+    }
+  }
+}
+`, ri)
+}
+
+func testAccApplication_fallbackPublicClient(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name                           = "acctest-APP-%[1]d"
+  type                           = "native"
+  fallback_public_client_enabled = true
+}
+`, ri)
+}
+
 func testAccApplication_appRoles(ri int) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -750,6 +849,27 @@ resource "azuread_application" "test" {
 `, ri)
 }
 
+func testAccApplication_appRolesCustomId(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctest-APP-%[1]d"
+
+  app_role {
+    id = "9a6eddbe-ca06-4b36-b648-4b8066ad1331"
+
+    allowed_member_types = [
+      "User",
+    ]
+
+    description  = "Admins can manage roles and perform all task actions"
+    display_name = "Admin"
+    is_enabled   = true
+    value        = "Admin"
+  }
+}
+`, ri)
+}
+
 func testAccApplication_appRolesNoValue(ri int) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
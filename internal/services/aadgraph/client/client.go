@@ -8,11 +8,12 @@ import (
 )
 
 type Client struct {
-	ApplicationsClient      *graphrbac.ApplicationsClient
-	DomainsClient           *graphrbac.DomainsClient
-	GroupsClient            *graphrbac.GroupsClient
-	ServicePrincipalsClient *graphrbac.ServicePrincipalsClient
-	UsersClient             *graphrbac.UsersClient
+	ApplicationsClient          *graphrbac.ApplicationsClient
+	DomainsClient               *graphrbac.DomainsClient
+	GroupsClient                *graphrbac.GroupsClient
+	OAuth2PermissionGrantClient *graphrbac.OAuth2PermissionGrantClient
+	ServicePrincipalsClient     *graphrbac.ServicePrincipalsClient
+	UsersClient                 *graphrbac.UsersClient
 }
 
 func BuildClient(o *services.ClientOptions, endpoint string, authorizer autorest.Authorizer) *Client {
@@ -25,6 +26,9 @@ func BuildClient(o *services.ClientOptions, endpoint string, authorizer autorest
 	groupsClient := graphrbac.NewGroupsClientWithBaseURI(endpoint, o.TenantID)
 	o.ConfigureClient(&groupsClient.Client, authorizer)
 
+	oauth2PermissionGrantClient := graphrbac.NewOAuth2PermissionGrantClientWithBaseURI(endpoint, o.TenantID)
+	o.ConfigureClient(&oauth2PermissionGrantClient.Client, authorizer)
+
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(endpoint, o.TenantID)
 	o.ConfigureClient(&servicePrincipalsClient.Client, authorizer)
 
@@ -32,10 +36,11 @@ func BuildClient(o *services.ClientOptions, endpoint string, authorizer autorest
 	o.ConfigureClient(&usersClient.Client, authorizer)
 
 	return &Client{
-		ApplicationsClient:      &applicationsClient,
-		DomainsClient:           &domainsClient,
-		GroupsClient:            &groupsClient,
-		ServicePrincipalsClient: &servicePrincipalsClient,
-		UsersClient:             &usersClient,
+		ApplicationsClient:          &applicationsClient,
+		DomainsClient:               &domainsClient,
+		GroupsClient:                &groupsClient,
+		OAuth2PermissionGrantClient: &oauth2PermissionGrantClient,
+		ServicePrincipalsClient:     &servicePrincipalsClient,
+		UsersClient:                 &usersClient,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/graph"
 )
 
 func clientConfigData() *schema.Resource {
@@ -32,6 +33,51 @@ func clientConfigData() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"authentication_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"claims": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"roles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"scopes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"user_principal_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"wids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -56,10 +102,55 @@ func clientConfigDataRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	d.SetId(fmt.Sprintf("%s-%s-%s", client.TenantID, client.ObjectID, client.ClientID))
+	claims, err := graph.ParseClaims(client.AadGraph.UsersClient.Authorizer)
+	if err != nil {
+		return fmt.Errorf("parsing claims from access token: %+v", err)
+	}
+
+	objectId := client.ObjectID
+	if objectId == "" {
+		// the authenticated object ID is not always resolved at provider initialisation,
+		// e.g. when authenticating with MSI, so fall back to the `oid` claim
+		objectId = claims.ObjectId
+	}
+
+	authenticationMethod := "user"
+	if client.AuthenticatedAsAServicePrincipal {
+		authenticationMethod = "servicePrincipal"
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%s", client.TenantID, objectId, client.ClientID))
 	d.Set("client_id", client.ClientID)
-	d.Set("object_id", client.ObjectID)
+	d.Set("object_id", objectId)
 	d.Set("tenant_id", client.TenantID)
+	d.Set("authentication_method", authenticationMethod)
+
+	if err := d.Set("claims", flattenClaims(claims)); err != nil {
+		return fmt.Errorf("setting `claims`: %+v", err)
+	}
 
 	return nil
 }
+
+func flattenClaims(claims graph.Claims) []interface{} {
+	roles := make([]interface{}, 0)
+	for _, v := range claims.Roles {
+		roles = append(roles, v)
+	}
+
+	wids := make([]interface{}, 0)
+	for _, v := range claims.Wids {
+		wids = append(wids, v)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"app_id":              claims.AppId,
+			"object_id":           claims.ObjectId,
+			"roles":               roles,
+			"scopes":              claims.Scopes,
+			"user_principal_name": claims.UserPrincipalName,
+			"wids":                wids,
+		},
+	}
+}
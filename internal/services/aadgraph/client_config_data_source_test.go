@@ -26,6 +26,9 @@ func TestAccClientConfigDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dsn, "client_id", clientId),
 					resource.TestCheckResourceAttr(dsn, "tenant_id", tenantId),
 					testClientConfigGUIDAttr(dsn, "object_id"),
+					resource.TestCheckResourceAttr(dsn, "authentication_method", "servicePrincipal"),
+					resource.TestCheckResourceAttr(dsn, "claims.0.app_id", clientId),
+					testClientConfigGUIDAttr(dsn, "claims.0.object_id"),
 				),
 			},
 		},
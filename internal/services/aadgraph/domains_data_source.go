@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
 )
 
 func domainsData() *schema.Resource {
@@ -18,17 +19,34 @@ func domainsData() *schema.Resource {
 			"include_unverified": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"only_default", "only_initial"}, //default or initial domains have to be verified
+				ConflictsWith: []string{"only_default", "only_initial", "only_root"}, //default, initial or root domains have to be verified
 			},
 			"only_default": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"only_initial"},
+				ConflictsWith: []string{"only_initial", "only_root"},
 			},
 			"only_initial": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"only_default"},
+				ConflictsWith: []string{"only_default", "only_root"},
+			},
+			"only_root": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"only_default", "only_initial"},
+			},
+			"admin_managed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"supports_services": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
 			},
 			"domains": {
 				Type:     schema.TypeList,
@@ -43,6 +61,10 @@ func domainsData() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"is_admin_managed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"is_default": {
 							Type:     schema.TypeBool,
 							Computed: true,
@@ -51,10 +73,19 @@ func domainsData() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"is_root": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"is_verified": {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"supported_services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -62,14 +93,31 @@ func domainsData() *schema.Resource {
 	}
 }
 
+type domainsDataFilters struct {
+	includeUnverified bool
+	onlyDefault       bool
+	onlyInitial       bool
+	onlyRoot          bool
+	adminManaged      bool
+	supportsServices  []string
+}
+
 func domainsDataRead(d *schema.ResourceData, meta interface{}) error {
 	tenantId := meta.(*clients.AadClient).TenantID
 	client := meta.(*clients.AadClient).AadGraph.DomainsClient
 	ctx := meta.(*clients.AadClient).StopContext
 
-	includeUnverified := d.Get("include_unverified").(bool)
-	onlyDefault := d.Get("only_default").(bool)
-	onlyInitial := d.Get("only_initial").(bool)
+	filters := domainsDataFilters{
+		includeUnverified: d.Get("include_unverified").(bool),
+		onlyDefault:       d.Get("only_default").(bool),
+		onlyInitial:       d.Get("only_initial").(bool),
+		onlyRoot:          d.Get("only_root").(bool),
+		adminManaged:      d.Get("admin_managed").(bool),
+	}
+
+	for _, v := range d.Get("supports_services").([]interface{}) {
+		filters.supportsServices = append(filters.supportsServices, v.(string))
+	}
 
 	results, err := client.List(ctx, "")
 	if err != nil {
@@ -78,7 +126,7 @@ func domainsDataRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId("domains-" + tenantId) // todo this should be more unique
 
-	domains := flattenDomains(results.Value, includeUnverified, onlyDefault, onlyInitial)
+	domains := flattenDomains(results.Value, filters)
 	if len(domains) == 0 {
 		return fmt.Errorf("no domains were returned based on those filters")
 	}
@@ -90,7 +138,7 @@ func domainsDataRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func flattenDomains(input *[]graphrbac.Domain, includeUnverified, onlyDefault, onlyInitial bool) []interface{} {
+func flattenDomains(input *[]graphrbac.Domain, filters domainsDataFilters) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -109,6 +157,11 @@ func flattenDomains(input *[]graphrbac.Domain, includeUnverified, onlyDefault, o
 			authenticationType = *v.AuthenticationType
 		}
 
+		isAdminManaged := false
+		if v.AdditionalProperties["isAdminManaged"] != nil {
+			isAdminManaged = v.AdditionalProperties["isAdminManaged"].(bool)
+		}
+
 		isDefault := false
 		if v.IsDefault != nil {
 			isDefault = *v.IsDefault
@@ -119,36 +172,77 @@ func flattenDomains(input *[]graphrbac.Domain, includeUnverified, onlyDefault, o
 			isInitial = v.AdditionalProperties["isInitial"].(bool)
 		}
 
+		isRoot := false
+		if v.AdditionalProperties["isRoot"] != nil {
+			isRoot = v.AdditionalProperties["isRoot"].(bool)
+		}
+
 		isVerified := false
 		if v.IsVerified != nil {
 			isVerified = *v.IsVerified
 		}
 
+		supportedServices := make([]interface{}, 0)
+		if services, ok := v.AdditionalProperties["supportedServices"].([]interface{}); ok {
+			supportedServices = services
+		}
+
 		// Filters
-		if !isDefault && onlyDefault {
-			// skip all domains except the initial domain
+		if !isDefault && filters.onlyDefault {
+			// skip all domains except the default domain
 			log.Printf("[DEBUG] Skipping %q since the filter requires the default domain", domainName)
 			continue
 		}
 
-		if !isInitial && onlyInitial {
+		if !isInitial && filters.onlyInitial {
 			// skip all domains except the initial domain
 			log.Printf("[DEBUG] Skipping %q since the filter requires the initial domain", domainName)
 			continue
 		}
 
-		if !isVerified && !includeUnverified {
+		if !isRoot && filters.onlyRoot {
+			// skip subdomains, whose verification status follows their root domain
+			log.Printf("[DEBUG] Skipping %q since the filter requires root domains", domainName)
+			continue
+		}
+
+		if !isAdminManaged && filters.adminManaged {
+			// skip domains whose DNS records are not managed by the admin
+			log.Printf("[DEBUG] Skipping %q since the filter requires admin managed domains", domainName)
+			continue
+		}
+
+		if !isVerified && !filters.includeUnverified {
 			//skip unverified domains
 			log.Printf("[DEBUG] Skipping %q since the filter requires verified domains", domainName)
 			continue
 		}
 
+		if len(filters.supportsServices) > 0 {
+			supported := 0
+			for _, serviceNeeded := range filters.supportsServices {
+				for _, serviceSupported := range supportedServices {
+					if serviceNeeded == serviceSupported.(string) {
+						supported++
+						break
+					}
+				}
+			}
+			if supported != len(filters.supportsServices) {
+				log.Printf("[DEBUG] Skipping %q since the filter requires services %v to be supported", domainName, filters.supportsServices)
+				continue
+			}
+		}
+
 		domain := map[string]interface{}{
 			"authentication_type": authenticationType,
 			"domain_name":         domainName,
+			"is_admin_managed":    isAdminManaged,
 			"is_default":          isDefault,
 			"is_initial":          isInitial,
+			"is_root":             isRoot,
 			"is_verified":         isVerified,
+			"supported_services":  supportedServices,
 		}
 
 		domains = append(domains, domain)
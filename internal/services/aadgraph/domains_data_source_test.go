@@ -69,6 +69,43 @@ func TestAccDomainsDataSource_onlyInitial(t *testing.T) {
 	})
 }
 
+func TestAccDomainsDataSource_onlyRoot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_domains", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsDataSource_onlyRoot,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "domains.0.domain_name"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "domains.0.is_verified"),
+					resource.TestCheckResourceAttr(data.ResourceName, "domains.0.is_root", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDomainsDataSource_supportsServices(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_domains", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsDataSource_supportsServices,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "domains.0.domain_name"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "domains.0.supported_services.#"),
+				),
+			},
+		},
+	})
+}
+
 const testAccDomainsDataSource_basic = `
 data "azuread_domains" "test" {}
 `
@@ -84,3 +121,15 @@ data "azuread_domains" "test" {
   only_initial = true
 }
 `
+
+const testAccDomainsDataSource_onlyRoot = `
+data "azuread_domains" "test" {
+  only_root = true
+}
+`
+
+const testAccDomainsDataSource_supportsServices = `
+data "azuread_domains" "test" {
+  supports_services = ["Email"]
+}
+`
@@ -258,6 +258,33 @@ func FlattenOauth2Permissions(in *[]graphrbac.OAuth2Permission) []map[string]int
 	return result
 }
 
+type ApplicationOwnerId struct {
+	ObjectSubResourceId
+	ObjectId string
+	OwnerId  string
+}
+
+func ApplicationOwnerIdFrom(objectId, ownerId string) ApplicationOwnerId {
+	return ApplicationOwnerId{
+		ObjectSubResourceId: ObjectSubResourceIdFrom(objectId, "owner", ownerId),
+		ObjectId:            objectId,
+		OwnerId:             ownerId,
+	}
+}
+
+func ParseApplicationOwnerId(idString string) (*ApplicationOwnerId, error) {
+	id, err := ParseObjectSubResourceId(idString, "owner")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Application Owner ID: %v", err)
+	}
+
+	return &ApplicationOwnerId{
+		ObjectSubResourceId: *id,
+		ObjectId:            id.objectId,
+		OwnerId:             id.subId,
+	}, nil
+}
+
 func ApplicationAllOwners(ctx context.Context, client *graphrbac.ApplicationsClient, appId string) ([]string, error) {
 	owners, err := client.ListOwnersComplete(ctx, appId)
 
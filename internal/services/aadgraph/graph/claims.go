@@ -0,0 +1,54 @@
+package graph
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Claims is the set of token claims we are interested in surfacing from the access
+// token used to authenticate the provider.
+type Claims struct {
+	Audience          string   `json:"aud"`
+	Issuer            string   `json:"iss"`
+	AppId             string   `json:"appid"`
+	ObjectId          string   `json:"oid"`
+	Roles             []string `json:"roles"`
+	Scopes            string   `json:"scp"`
+	TenantId          string   `json:"tid"`
+	UserPrincipalName string   `json:"upn"`
+	Wids              []string `json:"wids"`
+}
+
+// ParseClaims decorates a throwaway request using the given authorizer and decodes the
+// claims from the resulting bearer token. The token signature is not verified since the
+// token was obtained by us directly from the identity platform.
+func ParseClaims(authorizer autorest.Authorizer) (Claims, error) {
+	var claims Claims
+
+	req, err := autorest.Prepare(&http.Request{Header: http.Header{}}, authorizer.WithAuthorization())
+	if err != nil {
+		return claims, fmt.Errorf("preparing request to obtain access token: %+v", err)
+	}
+
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims, fmt.Errorf("access token does not appear to be a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return claims, fmt.Errorf("decoding access token payload: %+v", err)
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, fmt.Errorf("unmarshalling access token claims: %+v", err)
+	}
+
+	return claims, nil
+}
@@ -1,7 +1,11 @@
 package graph
 
 import (
+	"crypto/sha1"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"strings"
@@ -77,6 +81,15 @@ func CertificateResourceSchema(idAttribute string) map[string]*schema.Schema {
 			ConflictsWith: []string{"end_date"},
 			ValidateFunc:  validate.NoEmptyStrings,
 		},
+
+		"rotate_when_changed": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 	}
 }
 
@@ -137,6 +150,15 @@ func PasswordResourceSchema(idAttribute string) map[string]*schema.Schema {
 			ExactlyOneOf: []string{"end_date"},
 			ValidateFunc: validate.NoEmptyStrings,
 		},
+
+		"rotate_when_changed": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 	}
 }
 
@@ -342,10 +364,74 @@ func WaitForPasswordCredentialReplication(keyId string, timeout time.Duration, f
 	}).WaitForState()
 }
 
+// ParseCertificateValue decodes an X.509 certificate supplied as PEM, base64 DER or hex,
+// detecting the encoding automatically, and returns the raw DER bytes
+func ParseCertificateValue(value string) ([]byte, error) {
+	candidates := make([][]byte, 0)
+
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		candidates = append(candidates, block.Bytes)
+	}
+
+	normalized := strings.Join(strings.Fields(value), "")
+
+	if der, err := hex.DecodeString(normalized); err == nil {
+		candidates = append(candidates, der)
+	}
+
+	if der, err := base64.StdEncoding.DecodeString(normalized); err == nil {
+		candidates = append(candidates, der)
+	}
+
+	for _, der := range candidates {
+		if _, err := x509.ParseCertificate(der); err == nil {
+			return der, nil
+		}
+	}
+
+	return nil, fmt.Errorf("certificate value could not be parsed as an X.509 certificate in PEM, base64 or hex encoding")
+}
+
+// CertificateThumbprint returns the uppercase hex SHA-1 thumbprint of the given DER
+// encoded certificate, as displayed in the Azure portal
+func CertificateThumbprint(der []byte) string {
+	checksum := sha1.Sum(der) //nolint:gosec // thumbprints are defined to be SHA-1
+	return strings.ToUpper(hex.EncodeToString(checksum[:]))
+}
+
+// ValidateCertificateDiff is a CustomizeDiff function which checks that `value` can be
+// parsed as an X.509 certificate, so that malformed values fail at plan time. Symmetric
+// keys are opaque and cannot be checked, so they are only validated by the API at apply time.
+func ValidateCertificateDiff(diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("type").(string) != "AsymmetricX509Cert" {
+		return nil
+	}
+
+	if _, err := ParseCertificateValue(diff.Get("value").(string)); err != nil {
+		return fmt.Errorf("`value`: %v", err)
+	}
+
+	return nil
+}
+
 func KeyCredentialForResource(d *schema.ResourceData) (*graphrbac.KeyCredential, error) {
 	keyType := d.Get("type").(string)
 	value := d.Get("value").(string)
-	encodedValue := base64.StdEncoding.EncodeToString([]byte(value))
+
+	var encodedValue string
+	var customKeyIdentifier *string
+	if keyType == "AsymmetricX509Cert" {
+		der, err := ParseCertificateValue(value)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue = base64.StdEncoding.EncodeToString(der)
+
+		// the thumbprint doubles as the key identifier, as set by the portal
+		customKeyIdentifier = utils.String(CertificateThumbprint(der))
+	} else {
+		encodedValue = base64.StdEncoding.EncodeToString([]byte(value))
+	}
 
 	// errors should be handled by the validation
 	var keyId string
@@ -378,11 +464,12 @@ func KeyCredentialForResource(d *schema.ResourceData) (*graphrbac.KeyCredential,
 	}
 
 	credential := graphrbac.KeyCredential{
-		KeyID:   utils.String(keyId),
-		Type:    utils.String(keyType),
-		Usage:   utils.String("verify"),
-		Value:   utils.String(encodedValue),
-		EndDate: &date.Time{Time: endDate},
+		KeyID:               utils.String(keyId),
+		Type:                utils.String(keyType),
+		Usage:               utils.String("verify"),
+		Value:               utils.String(encodedValue),
+		CustomKeyIdentifier: customKeyIdentifier,
+		EndDate:             &date.Time{Time: endDate},
 	}
 
 	if v, ok := d.GetOk("start_date"); ok {
@@ -452,6 +539,63 @@ func KeyCredentialResultRemoveByKeyId(existing graphrbac.KeyCredentialListResult
 	return &newCreds
 }
 
+// KeyCredentialDatesFromResource computes the start and end dates for a key credential
+// from the `start_date`, `end_date` and `end_date_relative` resource attributes
+func KeyCredentialDatesFromResource(d *schema.ResourceData) (*date.Time, *date.Time, error) {
+	var startDate *date.Time
+	if v, ok := d.GetOk("start_date"); ok {
+		parsed, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse the provided start date %q: %+v", v, err)
+		}
+		startDate = &date.Time{Time: parsed}
+	}
+
+	var endDate *date.Time
+	if v := d.Get("end_date").(string); v != "" {
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse the provided end date %q: %+v", v, err)
+		}
+		endDate = &date.Time{Time: parsed}
+	} else if v := d.Get("end_date_relative").(string); v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse `end_date_relative` (%s) as a duration", v)
+		}
+		endDate = &date.Time{Time: time.Now().Add(duration)}
+	} else {
+		return nil, nil, fmt.Errorf("one of `end_date` or `end_date_relative` must be specified")
+	}
+
+	return startDate, endDate, nil
+}
+
+// KeyCredentialResultUpdateDates returns the existing credential list with the start and
+// end dates of the credential identified by keyId replaced
+func KeyCredentialResultUpdateDates(existing graphrbac.KeyCredentialListResult, keyId string, startDate, endDate *date.Time) (*[]graphrbac.KeyCredential, error) {
+	newCreds := make([]graphrbac.KeyCredential, 0)
+	found := false
+
+	if existing.Value != nil {
+		for _, v := range *existing.Value {
+			if v.KeyID != nil && *v.KeyID == keyId {
+				v.StartDate = startDate
+				v.EndDate = endDate
+				found = true
+			}
+
+			newCreds = append(newCreds, v)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("key credential with ID %q was not found", keyId)
+	}
+
+	return &newCreds, nil
+}
+
 func WaitForKeyCredentialReplication(keyId string, timeout time.Duration, f func() (graphrbac.KeyCredentialListResult, error)) (interface{}, error) {
 	return (&resource.StateChangeConf{
 		Pending:                   []string{"NotFound"},
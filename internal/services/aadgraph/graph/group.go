@@ -70,6 +70,66 @@ func GroupGetByDisplayName(ctx context.Context, client *graphrbac.GroupsClient,
 	return &group, nil
 }
 
+func GroupGetByMail(ctx context.Context, client *graphrbac.GroupsClient, mail string) (*graphrbac.ADGroup, error) {
+	filter := fmt.Sprintf("mail eq '%s'", mail)
+
+	resp, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing Groups for filter %q: %+v", filter, err)
+	}
+
+	values := resp.Response().Value
+	if values == nil {
+		return nil, fmt.Errorf("nil values for Groups matching %q", filter)
+	}
+	if len(*values) == 0 {
+		return nil, fmt.Errorf("found no Groups matching %q", filter)
+	}
+	if len(*values) > 1 {
+		return nil, fmt.Errorf("found multiple Groups matching %q", filter)
+	}
+
+	group := (*values)[0]
+	if group.Mail == nil {
+		return nil, fmt.Errorf("nil Mail for Group matching %q", filter)
+	}
+	if !strings.EqualFold(*group.Mail, mail) {
+		return nil, fmt.Errorf("Mail for Group matching %q does not match (%q!=%q)", filter, *group.Mail, mail)
+	}
+
+	return &group, nil
+}
+
+func GroupGetByMailNickname(ctx context.Context, client *graphrbac.GroupsClient, mailNickname string) (*graphrbac.ADGroup, error) {
+	filter := fmt.Sprintf("mailNickname eq '%s'", mailNickname)
+
+	resp, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing Groups for filter %q: %+v", filter, err)
+	}
+
+	values := resp.Response().Value
+	if values == nil {
+		return nil, fmt.Errorf("nil values for Groups matching %q", filter)
+	}
+	if len(*values) == 0 {
+		return nil, fmt.Errorf("found no Groups matching %q", filter)
+	}
+	if len(*values) > 1 {
+		return nil, fmt.Errorf("found multiple Groups matching %q", filter)
+	}
+
+	group := (*values)[0]
+	if group.MailNickname == nil {
+		return nil, fmt.Errorf("nil MailNickname for Group matching %q", filter)
+	}
+	if !strings.EqualFold(*group.MailNickname, mailNickname) {
+		return nil, fmt.Errorf("MailNickname for Group matching %q does not match (%q!=%q)", filter, *group.MailNickname, mailNickname)
+	}
+
+	return &group, nil
+}
+
 func DirectoryObjectListToIDs(ctx context.Context, objects graphrbac.DirectoryObjectListResultIterator) ([]string, error) {
 	errBase := "during pagination of directory objects"
 	ids := make([]string, 0)
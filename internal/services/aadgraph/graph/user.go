@@ -44,6 +44,34 @@ func UserGetByObjectId(ctx context.Context, client *graphrbac.UsersClient, objec
 	return &user, nil
 }
 
+func UserGetByEmployeeId(ctx context.Context, client *graphrbac.UsersClient, employeeId string) (*graphrbac.User, error) {
+	filter := fmt.Sprintf("employeeId eq '%s'", employeeId)
+	resp, err := client.ListComplete(ctx, filter, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing Azure AD Users for filter %q: %+v", filter, err)
+	}
+
+	values := resp.Response().Value
+	if values == nil {
+		return nil, fmt.Errorf("nil values for AD Users matching %q", filter)
+	}
+	if len(*values) == 0 {
+		return nil, nil
+	}
+	// employee IDs are not guaranteed to be unique within a tenant, so any
+	// additional match makes the lookup ambiguous
+	if len(*values) > 1 {
+		return nil, fmt.Errorf("found multiple AD Users matching %q", filter)
+	}
+
+	user := (*values)[0]
+	if user.DisplayName == nil {
+		return nil, fmt.Errorf("nil DisplayName for AD Users matching %q", filter)
+	}
+
+	return &user, nil
+}
+
 func UserGetByMailNickname(ctx context.Context, client *graphrbac.UsersClient, mailNickname string) (*graphrbac.User, error) {
 	filter := fmt.Sprintf("mailNickname eq '%s'", mailNickname)
 	resp, err := client.ListComplete(ctx, filter, "")
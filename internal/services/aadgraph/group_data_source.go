@@ -25,7 +25,7 @@ func groupData() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				ValidateFunc:  validate.UUID,
-				ConflictsWith: []string{"name"},
+				ConflictsWith: []string{"name", "mail", "mail_nickname"},
 			},
 
 			"description": {
@@ -38,7 +38,23 @@ func groupData() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				ValidateFunc:  validate.NoEmptyStrings,
-				ConflictsWith: []string{"object_id"},
+				ConflictsWith: []string{"object_id", "mail", "mail_nickname"},
+			},
+
+			"mail": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"object_id", "name", "mail_nickname"},
+			},
+
+			"mail_nickname": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"object_id", "name", "mail"},
 			},
 
 			"members": {
@@ -79,8 +95,20 @@ func groupDataRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("finding Group with display name %q: %+v", name, err)
 		}
 		group = *g
+	} else if mail, ok := d.Get("mail").(string); ok && mail != "" {
+		g, err := graph.GroupGetByMail(ctx, client, mail)
+		if err != nil {
+			return fmt.Errorf("finding Group with mail address %q: %+v", mail, err)
+		}
+		group = *g
+	} else if mailNickname, ok := d.Get("mail_nickname").(string); ok && mailNickname != "" {
+		g, err := graph.GroupGetByMailNickname(ctx, client, mailNickname)
+		if err != nil {
+			return fmt.Errorf("finding Group with mail nickname %q: %+v", mailNickname, err)
+		}
+		group = *g
 	} else {
-		return fmt.Errorf("one of `object_id` or `name` must be supplied")
+		return fmt.Errorf("one of `object_id`, `name`, `mail` or `mail_nickname` must be supplied")
 	}
 
 	if group.ObjectID == nil {
@@ -90,6 +118,8 @@ func groupDataRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("object_id", group.ObjectID)
 	d.Set("name", group.DisplayName)
+	d.Set("mail", group.Mail)
+	d.Set("mail_nickname", group.MailNickname)
 
 	if v, ok := group.AdditionalProperties["description"]; ok {
 		d.Set("description", v.(string))
@@ -29,6 +29,26 @@ func TestAccGroupDataSource_byName(t *testing.T) {
 	})
 }
 
+func TestAccGroupDataSource_byMailNickname(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupDataSource_mailNickname(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "name", fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "mail_nickname"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGroupDataSource_byCaseInsensitiveName(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_group", "test")
 
@@ -109,6 +129,20 @@ func TestAccGroupDataSource_owners(t *testing.T) {
 	})
 }
 
+func testAccGroupDataSource_mailNickname(id int) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuread_group" "byname" {
+  name = azuread_group.test.name
+}
+
+data "azuread_group" "test" {
+  mail_nickname = data.azuread_group.byname.mail_nickname
+}
+`, testAccGroup_basic(id))
+}
+
 func testAccGroupDataSource_name(id int) string {
 	return fmt.Sprintf(`
 %s
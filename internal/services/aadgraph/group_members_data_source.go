@@ -0,0 +1,73 @@
+package aadgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func groupMembersData() *schema.Resource {
+	return &schema.Resource{
+		Read: groupMembersDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"transitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"object_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func groupMembersDataRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := meta.(*clients.AadClient).StopContext
+
+	groupId := d.Get("group_object_id").(string)
+	transitive := d.Get("transitive").(bool)
+
+	var members []string
+	var err error
+
+	if transitive {
+		// nested group memberships are only resolvable through Microsoft Graph
+		client := meta.(*clients.AadClient).MsGraph.GroupsClient
+		members, err = client.ListTransitiveMembers(ctx, groupId)
+		if err != nil {
+			return fmt.Errorf("listing transitive members for Group with ID %q: %+v", groupId, err)
+		}
+	} else {
+		client := meta.(*clients.AadClient).AadGraph.GroupsClient
+		members, err = graph.GroupAllMembers(ctx, client, groupId)
+		if err != nil {
+			return fmt.Errorf("listing members for Group with ID %q: %+v", groupId, err)
+		}
+	}
+
+	idSuffix := "members"
+	if transitive {
+		idSuffix = "transitiveMembers"
+	}
+	d.SetId(fmt.Sprintf("%s-%s", groupId, idSuffix))
+
+	if err := d.Set("object_ids", members); err != nil {
+		return fmt.Errorf("setting `object_ids`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package aadgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccGroupMembersDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group_members", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembersDataSource_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(data.ResourceName, "object_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupMembersDataSource_transitive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group_members", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembersDataSource_transitive(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(data.ResourceName, "object_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupMembersDataSource_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "member" {
+  name = "acctestGroup-%[1]d-Member"
+}
+
+resource "azuread_group" "test" {
+  name    = "acctestGroup-%[1]d"
+  members = [azuread_group.member.object_id]
+}
+
+data "azuread_group_members" "test" {
+  group_object_id = azuread_group.test.object_id
+}
+`, id)
+}
+
+func testAccGroupMembersDataSource_transitive(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "nested" {
+  name = "acctestGroup-%[1]d-Nested"
+}
+
+resource "azuread_group" "member" {
+  name    = "acctestGroup-%[1]d-Member"
+  members = [azuread_group.nested.object_id]
+}
+
+resource "azuread_group" "test" {
+  name    = "acctestGroup-%[1]d"
+  members = [azuread_group.member.object_id]
+}
+
+data "azuread_group_members" "test" {
+  group_object_id = azuread_group.test.object_id
+  transitive      = true
+}
+`, id)
+}
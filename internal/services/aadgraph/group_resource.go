@@ -11,6 +11,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/graph"
+	msgraph "github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
@@ -44,17 +45,81 @@ func groupResource() *schema.Resource {
 				Optional: true,
 			},
 
-			"members": {
+			"behaviors": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				ForceNew: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						msgraph.GroupResourceBehaviorOptionAllowOnlyMembersToPost,
+						msgraph.GroupResourceBehaviorOptionHideGroupInOutlook,
+						msgraph.GroupResourceBehaviorOptionSubscribeNewGroupMembers,
+						msgraph.GroupResourceBehaviorOptionWelcomeEmailDisabled,
+					}, false),
+				},
+			},
+
+			"provisioning_options": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						msgraph.GroupResourceProvisioningOptionTeam,
+					}, false),
+				},
+			},
+
+			"mail_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"mail_nickname": {
+				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
+			},
+
+			"types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
 				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						msgraph.GroupTypeUnified,
+					}, false),
+				},
+			},
+
+			"members": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Set:           schema.HashString,
+				ConflictsWith: []string{"external_members"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.UUID,
 				},
 			},
 
+			"external_members": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"members"},
+			},
+
 			"owners": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -66,6 +131,49 @@ func groupResource() *schema.Resource {
 				},
 			},
 
+			"membership_rule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"membership_rule_processing_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					msgraph.MembershipRuleProcessingStateOn,
+					msgraph.MembershipRuleProcessingStatePaused,
+				}, false),
+			},
+
+			"writeback_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"onpremises_group_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					msgraph.OnPremisesGroupTypeUniversalDistributionGroup,
+					msgraph.OnPremisesGroupTypeUniversalMailEnabledSecurityGroup,
+					msgraph.OnPremisesGroupTypeUniversalSecurityGroup,
+				}, false),
+			},
+
+			"visibility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					msgraph.GroupVisibilityHiddenMembership,
+					msgraph.GroupVisibilityPrivate,
+					msgraph.GroupVisibilityPublic,
+				}, false),
+			},
+
 			"object_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -92,35 +200,88 @@ func groupResourceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	mailNickname, err := uuid.GenerateUUID()
-	if err != nil {
-		return fmt.Errorf("failed to generate mailNickname: %s", err)
+	mailNickname := d.Get("mail_nickname").(string)
+	if mailNickname == "" {
+		// this matches the portal behaviour
+		generated, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("failed to generate mailNickname: %s", err)
+		}
+		mailNickname = generated
 	}
 
-	properties := graphrbac.GroupCreateParameters{
-		DisplayName:          &name,
-		MailEnabled:          utils.Bool(false),          // we're defaulting to false, as the API currently only supports the creation of non-mail enabled security groups.
-		MailNickname:         utils.String(mailNickname), // this matches the portal behaviour
-		SecurityEnabled:      utils.Bool(true),           // we're defaulting to true, as the API currently only supports the creation of non-mail enabled security groups.
-		AdditionalProperties: make(map[string]interface{}),
-	}
+	mailEnabled := d.Get("mail_enabled").(bool)
+	groupTypes := *tf.ExpandStringSlicePtr(d.Get("types").(*schema.Set).List())
+	behaviors := *tf.ExpandStringSlicePtr(d.Get("behaviors").(*schema.Set).List())
+	provisioningOptions := *tf.ExpandStringSlicePtr(d.Get("provisioning_options").(*schema.Set).List())
+
+	var objectId string
+	if mailEnabled || len(groupTypes) > 0 || len(behaviors) > 0 || len(provisioningOptions) > 0 || d.Get("visibility").(string) != "" {
+		// mail enabled and Microsoft 365 groups can only be created through Microsoft Graph,
+		// as AAD Graph only supports the creation of non-mail enabled security groups
+		msClient := meta.(*clients.AadClient).MsGraph.GroupsClient
+
+		properties := msgraph.Group{
+			DisplayName:     &name,
+			GroupTypes:      &groupTypes,
+			MailEnabled:     utils.Bool(mailEnabled),
+			MailNickname:    utils.String(mailNickname),
+			SecurityEnabled: utils.Bool(!mailEnabled),
+		}
 
-	if v, ok := d.GetOk("description"); ok {
-		properties.AdditionalProperties["description"] = v.(string)
-	}
+		if len(behaviors) > 0 {
+			properties.ResourceBehaviorOptions = &behaviors
+		}
 
-	group, err := client.Create(ctx, properties)
-	if err != nil {
-		return fmt.Errorf("creating Group (%q): %+v", name, err)
-	}
-	if group.ObjectID == nil || *group.ObjectID == "" {
-		return fmt.Errorf("nil/blank Group ID for %q: %+v", name, err)
+		if len(provisioningOptions) > 0 {
+			properties.ResourceProvisioningOptions = &provisioningOptions
+		}
+
+		if v, ok := d.GetOk("visibility"); ok {
+			properties.Visibility = utils.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			properties.Description = utils.String(v.(string))
+		}
+
+		group, _, err := msClient.Create(ctx, properties)
+		if err != nil {
+			return fmt.Errorf("creating Group (%q): %+v", name, err)
+		}
+		if group.ID == nil || *group.ID == "" {
+			return fmt.Errorf("nil/blank Group ID for %q", name)
+		}
+
+		objectId = *group.ID
+	} else {
+		properties := graphrbac.GroupCreateParameters{
+			DisplayName:          &name,
+			MailEnabled:          utils.Bool(false),
+			MailNickname:         utils.String(mailNickname),
+			SecurityEnabled:      utils.Bool(true),
+			AdditionalProperties: make(map[string]interface{}),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			properties.AdditionalProperties["description"] = v.(string)
+		}
+
+		group, err := client.Create(ctx, properties)
+		if err != nil {
+			return fmt.Errorf("creating Group (%q): %+v", name, err)
+		}
+		if group.ObjectID == nil || *group.ObjectID == "" {
+			return fmt.Errorf("nil/blank Group ID for %q: %+v", name, err)
+		}
+
+		objectId = *group.ObjectID
 	}
 
-	d.SetId(*group.ObjectID)
+	d.SetId(objectId)
 
-	_, err = graph.WaitForCreationReplication(d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
-		return client.Get(ctx, *group.ObjectID)
+	_, err := graph.WaitForCreationReplication(d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return client.Get(ctx, objectId)
 	})
 
 	// Add members if specified
@@ -128,27 +289,40 @@ func groupResourceCreate(d *schema.ResourceData, meta interface{}) error {
 		members := tf.ExpandStringSlicePtr(v.(*schema.Set).List())
 
 		// we could lock here against the group member resource, but they should not be used together (todo conflicts with at a resource level?)
-		if err := graph.GroupAddMembers(ctx, client, *group.ObjectID, *members); err != nil {
+		if err := graph.GroupAddMembers(ctx, client, objectId, *members); err != nil {
 			return err
 		}
 	}
 
 	// Add owners if specified
 	if v, ok := d.GetOk("owners"); ok {
-		existingOwners, err := graph.GroupAllOwners(ctx, client, *group.ObjectID)
+		existingOwners, err := graph.GroupAllOwners(ctx, client, objectId)
 		if err != nil {
 			return err
 		}
 		members := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
 		ownersToAdd := utils.Difference(members, existingOwners)
 
-		if err := graph.GroupAddOwners(ctx, client, *group.ObjectID, ownersToAdd); err != nil {
+		if err := graph.GroupAddOwners(ctx, client, objectId, ownersToAdd); err != nil {
 			return err
 		}
 	}
 
 	if err != nil {
-		return fmt.Errorf("waiting for Group (%s) with ObjectId %q: %+v", name, *group.ObjectID, err)
+		return fmt.Errorf("waiting for Group (%s) with ObjectId %q: %+v", name, objectId, err)
+	}
+
+	// dynamic membership is only configurable through Microsoft Graph
+	if _, ok := d.GetOk("membership_rule"); ok {
+		if err := groupSetMembershipRule(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("writeback_enabled").(bool) || d.Get("onpremises_group_type").(string) != "" {
+		if err := groupSetWritebackConfiguration(d, meta); err != nil {
+			return err
+		}
 	}
 
 	return groupResourceRead(d, meta)
@@ -171,16 +345,22 @@ func groupResourceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", resp.DisplayName)
 	d.Set("object_id", resp.ObjectID)
+	d.Set("mail_enabled", resp.MailEnabled)
+	d.Set("mail_nickname", resp.MailNickname)
 
 	if v, ok := resp.AdditionalProperties["description"]; ok {
 		d.Set("description", v.(string))
 	}
 
-	members, err := graph.GroupAllMembers(ctx, client, d.Id())
-	if err != nil {
-		return err
+	// when membership is managed externally, e.g. with azuread_group_member or via SCIM
+	// provisioning, don't track the members of the group
+	if !d.Get("external_members").(bool) {
+		members, err := graph.GroupAllMembers(ctx, client, d.Id())
+		if err != nil {
+			return err
+		}
+		d.Set("members", members)
 	}
-	d.Set("members", members)
 
 	owners, err := graph.GroupAllOwners(ctx, client, d.Id())
 	if err != nil {
@@ -188,6 +368,55 @@ func groupResourceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("owners", owners)
 
+	msGroup, _, err := meta.(*clients.AadClient).MsGraph.GroupsClient.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("retrieving Group with ID %q from Microsoft Graph: %+v", d.Id(), err)
+	}
+
+	groupTypes := make([]string, 0)
+	if msGroup.GroupTypes != nil {
+		for _, groupType := range *msGroup.GroupTypes {
+			// dynamic membership is configured separately through membership_rule
+			if groupType != msgraph.GroupTypeDynamicMembership {
+				groupTypes = append(groupTypes, groupType)
+			}
+		}
+	}
+	d.Set("types", groupTypes)
+
+	// resourceBehaviorOptions is not returned by the API, so cannot be read back here
+	provisioningOptions := make([]string, 0)
+	if msGroup.ResourceProvisioningOptions != nil {
+		provisioningOptions = *msGroup.ResourceProvisioningOptions
+	}
+	d.Set("provisioning_options", provisioningOptions)
+
+	membershipRule := ""
+	if msGroup.MembershipRule != nil {
+		membershipRule = *msGroup.MembershipRule
+	}
+	d.Set("membership_rule", membershipRule)
+
+	if msGroup.MembershipRuleProcessingState != nil {
+		d.Set("membership_rule_processing_state", msGroup.MembershipRuleProcessingState)
+	}
+
+	if msGroup.Visibility != nil {
+		d.Set("visibility", msGroup.Visibility)
+	}
+
+	writebackEnabled := false
+	if msGroup.WritebackConfiguration != nil {
+		if msGroup.WritebackConfiguration.IsEnabled != nil {
+			writebackEnabled = *msGroup.WritebackConfiguration.IsEnabled
+		}
+
+		if msGroup.WritebackConfiguration.OnPremisesGroupType != nil {
+			d.Set("onpremises_group_type", msGroup.WritebackConfiguration.OnPremisesGroupType)
+		}
+	}
+	d.Set("writeback_enabled", writebackEnabled)
+
 	if preventDuplicates := d.Get("prevent_duplicate_names").(bool); !preventDuplicates {
 		d.Set("prevent_duplicate_names", false)
 	}
@@ -251,9 +480,99 @@ func groupResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("membership_rule") || d.HasChange("membership_rule_processing_state") {
+		if err := groupSetMembershipRule(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("writeback_enabled") || d.HasChange("onpremises_group_type") {
+		if err := groupSetWritebackConfiguration(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("visibility") {
+		oldVisibility, newVisibility := d.GetChange("visibility")
+		if oldVisibility.(string) == msgraph.GroupVisibilityHiddenMembership || newVisibility.(string) == msgraph.GroupVisibilityHiddenMembership {
+			return fmt.Errorf("`visibility` can only be set to or from %q when creating a group", msgraph.GroupVisibilityHiddenMembership)
+		}
+
+		properties := msgraph.Group{
+			Visibility: utils.String(newVisibility.(string)),
+		}
+
+		if _, err := meta.(*clients.AadClient).MsGraph.GroupsClient.Update(ctx, d.Id(), properties); err != nil {
+			return fmt.Errorf("setting visibility for Group with ID %q: %+v", d.Id(), err)
+		}
+	}
+
 	return groupResourceRead(d, meta)
 }
 
+func groupSetMembershipRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	group, _, err := client.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("retrieving Group with ID %q from Microsoft Graph: %+v", d.Id(), err)
+	}
+
+	groupTypes := make([]string, 0)
+	if group.GroupTypes != nil {
+		for _, groupType := range *group.GroupTypes {
+			if groupType != msgraph.GroupTypeDynamicMembership {
+				groupTypes = append(groupTypes, groupType)
+			}
+		}
+	}
+
+	properties := msgraph.Group{
+		GroupTypes: &groupTypes,
+	}
+
+	if rule := d.Get("membership_rule").(string); rule != "" {
+		groupTypes = append(groupTypes, msgraph.GroupTypeDynamicMembership)
+		properties.MembershipRule = utils.String(rule)
+
+		processingState := msgraph.MembershipRuleProcessingStateOn
+		if v, ok := d.GetOk("membership_rule_processing_state"); ok {
+			processingState = v.(string)
+		}
+		properties.MembershipRuleProcessingState = utils.String(processingState)
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("setting membership rule for Group with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func groupSetWritebackConfiguration(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	writeback := msgraph.GroupWritebackConfiguration{
+		IsEnabled: utils.Bool(d.Get("writeback_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("onpremises_group_type"); ok {
+		writeback.OnPremisesGroupType = utils.String(v.(string))
+	}
+
+	properties := msgraph.Group{
+		WritebackConfiguration: &writeback,
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("setting writeback configuration for Group with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
 func groupResourceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.AadClient).AadGraph.GroupsClient
 	ctx := meta.(*clients.AadClient).StopContext
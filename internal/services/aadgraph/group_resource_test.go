@@ -212,6 +212,157 @@ func TestAccGroup_ownersUpdate(t *testing.T) {
 	})
 }
 
+func TestAccGroup_unified(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroup_unified(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "types.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "mail_enabled", "true"),
+					resource.TestCheckResourceAttr(data.ResourceName, "mail_nickname", fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccGroup_behaviors(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroup_behaviors(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "behaviors.#", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "provisioning_options.#", "1"),
+				),
+			},
+			// behaviors are not returned by the API, so they cannot be verified on import
+			data.ImportStep("behaviors"),
+		},
+	})
+}
+
+func TestAccGroup_visibility(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroup_visibility(data.RandomInteger, "Private"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "visibility", "Private"),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccGroup_visibility(data.RandomInteger, "Public"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "visibility", "Public"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccGroup_writeback(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroup_writeback(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "writeback_enabled", "true"),
+					resource.TestCheckResourceAttr(data.ResourceName, "onpremises_group_type", "UniversalSecurityGroup"),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccGroup_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "writeback_enabled", "false"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccGroup_dynamicMembership(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroup_dynamicMembership(data.RandomInteger, "On"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "membership_rule", `user.department -eq "Sales"`),
+					resource.TestCheckResourceAttr(data.ResourceName, "membership_rule_processing_state", "On"),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccGroup_dynamicMembership(data.RandomInteger, "Paused"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "membership_rule", `user.department -eq "Sales"`),
+					resource.TestCheckResourceAttr(data.ResourceName, "membership_rule_processing_state", "Paused"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccGroup_externalMembers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroup_externalMembers(data.RandomInteger, data.RandomPassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "external_members", "true"),
+				),
+			},
+			data.ImportStep("external_members"),
+		},
+	})
+}
+
 func TestAccGroup_preventDuplicateNames(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 
@@ -446,6 +597,83 @@ resource "azuread_group" "test" {
 `, id)
 }
 
+func testAccGroup_unified(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name          = "acctestGroup-%[1]d"
+  types         = ["Unified"]
+  mail_enabled  = true
+  mail_nickname = "acctestGroup-%[1]d"
+}
+`, id)
+}
+
+func testAccGroup_behaviors(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name          = "acctestGroup-%[1]d"
+  types         = ["Unified"]
+  mail_enabled  = true
+  mail_nickname = "acctestGroup-%[1]d"
+
+  behaviors = [
+    "HideGroupInOutlook",
+    "WelcomeEmailDisabled",
+  ]
+
+  provisioning_options = ["Team"]
+}
+`, id)
+}
+
+func testAccGroup_visibility(id int, visibility string) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name          = "acctestGroup-%[1]d"
+  types         = ["Unified"]
+  mail_enabled  = true
+  mail_nickname = "acctestGroup-%[1]d"
+  visibility    = "%[2]s"
+}
+`, id, visibility)
+}
+
+func testAccGroup_writeback(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name                  = "acctestGroup-%[1]d"
+  writeback_enabled     = true
+  onpremises_group_type = "UniversalSecurityGroup"
+}
+`, id)
+}
+
+func testAccGroup_dynamicMembership(id int, processingState string) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name                             = "acctestGroup-%[1]d"
+  membership_rule                  = "user.department -eq \"Sales\""
+  membership_rule_processing_state = "%[2]s"
+}
+`, id, processingState)
+}
+
+func testAccGroup_externalMembers(id int, password string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "test" {
+  name             = "acctestGroup-%[2]d"
+  external_members = true
+}
+
+resource "azuread_group_member" "test" {
+  group_object_id  = azuread_group.test.object_id
+  member_object_id = azuread_user.test.object_id
+}
+`, testAccUser_basic(id, password), id)
+}
+
 func testAccGroup_duplicateName(id int) string {
 	return fmt.Sprintf(`
 %s
@@ -27,7 +27,7 @@ func groupsData() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"names", "object_ids"},
+				ExactlyOneOf: []string{"names", "object_ids", "display_name_prefix", "return_all"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.UUID,
@@ -38,12 +38,39 @@ func groupsData() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"names", "object_ids"},
+				ExactlyOneOf: []string{"names", "object_ids", "display_name_prefix", "return_all"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.NoEmptyStrings,
 				},
 			},
+
+			"display_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"names", "object_ids", "display_name_prefix", "return_all"},
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"return_all": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				ExactlyOneOf: []string{"names", "object_ids", "display_name_prefix", "return_all"},
+			},
+
+			// these only make sense when listing groups, as groups looked up individually are already fully identified
+			"mail_enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"names", "object_ids"},
+			},
+
+			"security_enabled": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"names", "object_ids"},
+			},
 		},
 	}
 }
@@ -55,7 +82,34 @@ func groupsDataRead(d *schema.ResourceData, meta interface{}) error {
 	var groups []graphrbac.ADGroup
 	expectedCount := 0
 
-	if names, ok := d.Get("names").([]interface{}); ok && len(names) > 0 {
+	displayNamePrefix := d.Get("display_name_prefix").(string)
+	returnAll := d.Get("return_all").(bool)
+
+	if returnAll || displayNamePrefix != "" {
+		filterClauses := make([]string, 0)
+		if displayNamePrefix != "" {
+			filterClauses = append(filterClauses, fmt.Sprintf("startswith(displayName,'%s')", displayNamePrefix))
+		}
+		if v, exists := d.GetOkExists("mail_enabled"); exists { //nolint:staticcheck // needed to detect unset booleans
+			filterClauses = append(filterClauses, fmt.Sprintf("mailEnabled eq %t", v.(bool)))
+		}
+		if v, exists := d.GetOkExists("security_enabled"); exists { //nolint:staticcheck // needed to detect unset booleans
+			filterClauses = append(filterClauses, fmt.Sprintf("securityEnabled eq %t", v.(bool)))
+		}
+		filter := strings.Join(filterClauses, " and ")
+
+		resp, err := client.ListComplete(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("listing Groups for filter %q: %+v", filter, err)
+		}
+		for resp.NotDone() {
+			groups = append(groups, resp.Value())
+			if err := resp.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Groups for filter %q: %+v", filter, err)
+			}
+		}
+		expectedCount = len(groups)
+	} else if names, ok := d.Get("names").([]interface{}); ok && len(names) > 0 {
 		expectedCount = len(names)
 		for _, v := range names {
 			g, err := graph.GroupGetByDisplayName(ctx, client, v.(string))
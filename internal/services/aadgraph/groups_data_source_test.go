@@ -2,6 +2,7 @@ package aadgraph_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -45,6 +46,60 @@ func TestAccGroupsDataSource_byObjectIds(t *testing.T) {
 	})
 }
 
+func TestAccGroupsDataSource_byDisplayNamePrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSource_byDisplayNamePrefix(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(data.ResourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "object_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupsDataSource_returnAll(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSource_returnAll(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(data.ResourceName, "names.#", regexp.MustCompile("^[1-9]")),
+					resource.TestMatchResourceAttr(data.ResourceName, "object_ids.#", regexp.MustCompile("^[1-9]")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupsDataSource_returnAllSecurityEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSource_returnAllSecurityEnabled(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(data.ResourceName, "names.#", regexp.MustCompile("^[1-9]")),
+					resource.TestMatchResourceAttr(data.ResourceName, "object_ids.#", regexp.MustCompile("^[1-9]")),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGroupsDataSource_noNames(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
 
@@ -97,6 +152,35 @@ data "azuread_groups" "test" {
 `, testAccGroup_multiple(id))
 }
 
+func testAccGroupsDataSource_byDisplayNamePrefix(id int) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuread_groups" "test" {
+  display_name_prefix = "acctestGroup-%d"
+
+  depends_on = [azuread_group.testA, azuread_group.testB]
+}
+`, testAccGroup_multiple(id), id)
+}
+
+func testAccGroupsDataSource_returnAll() string {
+	return `
+data "azuread_groups" "test" {
+  return_all = true
+}
+`
+}
+
+func testAccGroupsDataSource_returnAllSecurityEnabled() string {
+	return `
+data "azuread_groups" "test" {
+  return_all       = true
+  security_enabled = true
+}
+`
+}
+
 func testAccGroupsDataSource_noNames() string {
 	return `
 data "azuread_groups" "test" {
@@ -0,0 +1,158 @@
+package aadgraph
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func oauth2PermissionGrantsData() *schema.Resource {
+	return &schema.Resource{
+		Read: oauth2PermissionGrantsDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"client_object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"resource_object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"grants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"client_object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"consent_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"principal_object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func oauth2PermissionGrantsDataRead(d *schema.ResourceData, meta interface{}) error {
+	tenantId := meta.(*clients.AadClient).TenantID
+	client := meta.(*clients.AadClient).AadGraph.OAuth2PermissionGrantClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	clientId := d.Get("client_object_id").(string)
+	resourceId := d.Get("resource_object_id").(string)
+
+	filter := ""
+	if clientId != "" {
+		filter = fmt.Sprintf("clientId eq '%s'", clientId)
+	} else if resourceId != "" {
+		filter = fmt.Sprintf("resourceId eq '%s'", resourceId)
+	}
+
+	results, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("listing OAuth2 Permission Grants: %+v", err)
+	}
+
+	grants := make([]graphrbac.OAuth2PermissionGrant, 0)
+	for results.NotDone() {
+		grant := results.Value()
+
+		// resourceId is not a filterable property, so filter client side
+		if resourceId != "" && (grant.ResourceID == nil || *grant.ResourceID != resourceId) {
+			if err := results.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing OAuth2 Permission Grants: %+v", err)
+			}
+			continue
+		}
+
+		grants = append(grants, grant)
+
+		if err := results.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing OAuth2 Permission Grants: %+v", err)
+		}
+	}
+
+	id := "oauth2PermissionGrants-" + tenantId
+	if clientId != "" {
+		id = fmt.Sprintf("%s-%s", id, clientId)
+	}
+	if resourceId != "" {
+		id = fmt.Sprintf("%s-%s", id, resourceId)
+	}
+	d.SetId(id)
+
+	if err := d.Set("grants", flattenOauth2PermissionGrants(grants)); err != nil {
+		return fmt.Errorf("setting `grants`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenOauth2PermissionGrants(input []graphrbac.OAuth2PermissionGrant) []interface{} {
+	grants := make([]interface{}, 0)
+	for _, v := range input {
+		grant := map[string]interface{}{
+			"object_id":           "",
+			"client_object_id":    "",
+			"consent_type":        string(v.ConsentType),
+			"principal_object_id": "",
+			"resource_object_id":  "",
+			"scope":               "",
+		}
+
+		if v.ObjectID != nil {
+			grant["object_id"] = *v.ObjectID
+		}
+		if v.ClientID != nil {
+			grant["client_object_id"] = *v.ClientID
+		}
+		if v.PrincipalID != nil {
+			grant["principal_object_id"] = *v.PrincipalID
+		}
+		if v.ResourceID != nil {
+			grant["resource_object_id"] = *v.ResourceID
+		}
+		if v.Scope != nil {
+			grant["scope"] = *v.Scope
+		}
+
+		grants = append(grants, grant)
+	}
+
+	return grants
+}
@@ -0,0 +1,30 @@
+package aadgraph_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccOauth2PermissionGrantsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_oauth2_permission_grants", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOauth2PermissionGrantsDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "grants.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOauth2PermissionGrantsDataSource_basic = `
+data "azuread_oauth2_permission_grants" "test" {}
+`
@@ -15,6 +15,13 @@ import (
 )
 
 func servicePrincipalCertificateResource() *schema.Resource {
+	certificateSchema := graph.CertificateResourceSchema("service_principal_id")
+
+	certificateSchema["thumbprint"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
 	return &schema.Resource{
 		Create: servicePrincipalCertificateResourceCreate,
 		Read:   servicePrincipalCertificateResourceRead,
@@ -25,7 +32,10 @@ func servicePrincipalCertificateResource() *schema.Resource {
 			return err
 		}),
 
-		Schema: graph.CertificateResourceSchema("service_principal_id"),
+		// catch values which don't parse as a certificate at plan time, rather than at apply
+		CustomizeDiff: graph.ValidateCertificateDiff,
+
+		Schema: certificateSchema,
 	}
 }
 
@@ -121,6 +131,10 @@ func servicePrincipalCertificateResourceRead(d *schema.ResourceData, meta interf
 		d.Set("start_date", startDate.Format(time.RFC3339))
 	}
 
+	if thumbprint := credential.CustomKeyIdentifier; thumbprint != nil {
+		d.Set("thumbprint", thumbprint)
+	}
+
 	return nil
 }
 
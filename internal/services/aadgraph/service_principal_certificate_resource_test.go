@@ -114,6 +114,7 @@ func TestAccServicePrincipalCertificate_basic(t *testing.T) {
 				Config: testAccServicePrincipalCertificate_basic(data.RandomInteger, keyType, endDate, value),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckServicePrincipalKeyExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "thumbprint"),
 				),
 			},
 			data.ImportStep("value"),
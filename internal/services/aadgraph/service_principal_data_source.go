@@ -2,6 +2,7 @@ package aadgraph
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -77,20 +78,45 @@ func servicePrincipalDataRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("listing Service Principals: %+v", err)
 		}
 
-		for _, app := range *apps.Response().Value {
-			if app.DisplayName == nil {
-				continue
+		// the filter is case insensitive, and display names are not unique, so we collect
+		// all matches across every page of results and insist on a single exact match
+		var matches []graphrbac.ServicePrincipal
+		for apps.NotDone() {
+			app := apps.Value()
+			if app.DisplayName != nil && strings.EqualFold(*app.DisplayName, displayName) {
+				matches = append(matches, app)
 			}
 
-			if *app.DisplayName == displayName {
-				sp = &app
-				break
+			if err := apps.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Service Principals: %+v", err)
 			}
 		}
 
-		if sp == nil {
+		if len(matches) > 1 {
+			// fall back on a case sensitive match before giving up
+			var exactMatches []graphrbac.ServicePrincipal
+			for _, app := range matches {
+				if *app.DisplayName == displayName {
+					exactMatches = append(exactMatches, app)
+				}
+			}
+			matches = exactMatches
+		}
+
+		if len(matches) == 0 {
 			return fmt.Errorf("A Service Principal with the Display Name %q was not found", displayName)
 		}
+		if len(matches) > 1 {
+			objectIds := make([]string, 0)
+			for _, app := range matches {
+				if app.ObjectID != nil {
+					objectIds = append(objectIds, *app.ObjectID)
+				}
+			}
+			return fmt.Errorf("found multiple Service Principals with the Display Name %q (object IDs: %s) - specify `object_id` or `application_id` to select one", displayName, strings.Join(objectIds, ", "))
+		}
+
+		sp = &matches[0]
 	} else {
 		// use the application_id to find the Azure AD service principal
 		applicationId := d.Get("application_id").(string)
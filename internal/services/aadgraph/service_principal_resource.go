@@ -8,9 +8,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/graph"
+	msgraph "github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
@@ -50,17 +52,89 @@ func servicePrincipalResource() *schema.Resource {
 				Computed: true,
 			},
 
+			"login_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
 			"object_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
-			"oauth2_permissions": graph.SchemaOauth2PermissionsComputed(),
-
-			"tags": {
+			"notification_email_addresses": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.StringIsEmailAddress,
+				},
+			},
+
+			"oauth2_permissions": graph.SchemaOauth2PermissionsComputed(),
+
+			"preferred_single_sign_on_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"notSupported",
+					"oidc",
+					"password",
+					"saml",
+				}, false),
+			},
+
+			"saml_single_sign_on": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relay_state": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"feature_tags": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"tags"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_single_sign_on": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"enterprise": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"gallery": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"hide": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"tags": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Set:           schema.HashString,
+				ConflictsWith: []string{"feature_tags"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -86,7 +160,9 @@ func servicePrincipalResourceCreate(d *schema.ResourceData, meta interface{}) er
 		properties.AppRoleAssignmentRequired = utils.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("feature_tags"); ok {
+		properties.Tags = servicePrincipalExpandFeatureTags(v.([]interface{}))
+	} else if v, ok := d.GetOk("tags"); ok {
 		properties.Tags = tf.ExpandStringSlicePtr(v.(*schema.Set).List())
 	}
 
@@ -106,9 +182,120 @@ func servicePrincipalResourceCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("waiting for Service Principal with ObjectId %q: %+v", *sp.ObjectID, err)
 	}
 
+	if servicePrincipalHasSsoSettings(d) {
+		if err := servicePrincipalSetSsoSettings(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return servicePrincipalResourceRead(d, meta)
 }
 
+// the feature booleans map to well-known tag strings recognised by the portal
+const (
+	servicePrincipalTagCustomSingleSignOn = "WindowsAzureActiveDirectoryCustomSingleSignOnApplication"
+	servicePrincipalTagEnterprise         = "WindowsAzureActiveDirectoryIntegratedApp"
+	servicePrincipalTagGallery            = "WindowsAzureActiveDirectoryGalleryApplicationNonPrimaryV1"
+	servicePrincipalTagHide               = "HideApp"
+)
+
+func servicePrincipalExpandFeatureTags(input []interface{}) *[]string {
+	tags := make([]string, 0)
+
+	if len(input) == 0 || input[0] == nil {
+		return &tags
+	}
+
+	features := input[0].(map[string]interface{})
+
+	if features["custom_single_sign_on"].(bool) {
+		tags = append(tags, servicePrincipalTagCustomSingleSignOn)
+	}
+	if features["enterprise"].(bool) {
+		tags = append(tags, servicePrincipalTagEnterprise)
+	}
+	if features["gallery"].(bool) {
+		tags = append(tags, servicePrincipalTagGallery)
+	}
+	if features["hide"].(bool) {
+		tags = append(tags, servicePrincipalTagHide)
+	}
+
+	return &tags
+}
+
+func servicePrincipalFlattenFeatureTags(tags *[]string) []interface{} {
+	features := map[string]interface{}{
+		"custom_single_sign_on": false,
+		"enterprise":            false,
+		"gallery":               false,
+		"hide":                  false,
+	}
+
+	if tags != nil {
+		for _, tag := range *tags {
+			switch tag {
+			case servicePrincipalTagCustomSingleSignOn:
+				features["custom_single_sign_on"] = true
+			case servicePrincipalTagEnterprise:
+				features["enterprise"] = true
+			case servicePrincipalTagGallery:
+				features["gallery"] = true
+			case servicePrincipalTagHide:
+				features["hide"] = true
+			}
+		}
+	}
+
+	return []interface{}{features}
+}
+
+func servicePrincipalHasSsoSettings(d *schema.ResourceData) bool {
+	for _, field := range []string{"login_url", "notification_email_addresses", "preferred_single_sign_on_mode", "saml_single_sign_on"} {
+		if _, ok := d.GetOk(field); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// servicePrincipalSetSsoSettings sets the single sign-on properties for a service
+// principal, which are only exposed by Microsoft Graph.
+func servicePrincipalSetSsoSettings(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ServicePrincipalsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	var properties msgraph.ServicePrincipal
+
+	if v, ok := d.GetOk("login_url"); ok {
+		properties.LoginUrl = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_email_addresses"); ok {
+		properties.NotificationEmailAddresses = tf.ExpandStringSlicePtr(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("preferred_single_sign_on_mode"); ok {
+		properties.PreferredSingleSignOnMode = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("saml_single_sign_on"); ok {
+		if raw := v.([]interface{}); len(raw) > 0 && raw[0] != nil {
+			settings := raw[0].(map[string]interface{})
+			properties.SamlSingleSignOnSettings = &msgraph.SamlSingleSignOnSettings{
+				RelayState: utils.String(settings["relay_state"].(string)),
+			}
+		}
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("setting single sign-on properties for Service Principal with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
 func servicePrincipalResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.AadClient).AadGraph.ServicePrincipalsClient
 	ctx := meta.(*clients.AadClient).StopContext
@@ -119,7 +306,9 @@ func servicePrincipalResourceUpdate(d *schema.ResourceData, meta interface{}) er
 		properties.AppRoleAssignmentRequired = utils.Bool(d.Get("app_role_assignment_required").(bool))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("feature_tags") {
+		properties.Tags = servicePrincipalExpandFeatureTags(d.Get("feature_tags").([]interface{}))
+	} else if d.HasChange("tags") {
 		if v, ok := d.GetOk("tags"); ok {
 			properties.Tags = tf.ExpandStringSlicePtr(v.(*schema.Set).List())
 		} else {
@@ -132,6 +321,12 @@ func servicePrincipalResourceUpdate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("patching Service Principal with ID %q: %+v", d.Id(), err)
 	}
 
+	if d.HasChange("login_url") || d.HasChange("notification_email_addresses") || d.HasChange("preferred_single_sign_on_mode") || d.HasChange("saml_single_sign_on") {
+		if err := servicePrincipalSetSsoSettings(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return servicePrincipalResourceRead(d, meta)
 }
 
@@ -157,14 +352,45 @@ func servicePrincipalResourceRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("app_role_assignment_required", app.AppRoleAssignmentRequired)
 
 	// tags doesn't exist as a property, so extract it
-	if err := d.Set("tags", app.Tags); err != nil {
-		return fmt.Errorf("setting `tags`: %+v", err)
+	if _, ok := d.GetOk("feature_tags"); ok {
+		if err := d.Set("feature_tags", servicePrincipalFlattenFeatureTags(app.Tags)); err != nil {
+			return fmt.Errorf("setting `feature_tags`: %+v", err)
+		}
+	} else {
+		if err := d.Set("tags", app.Tags); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
 	}
 
 	if err := d.Set("oauth2_permissions", graph.FlattenOauth2Permissions(app.Oauth2Permissions)); err != nil {
 		return fmt.Errorf("setting `oauth2_permissions`: %+v", err)
 	}
 
+	msSp, _, err := meta.(*clients.AadClient).MsGraph.ServicePrincipalsClient.Get(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("retrieving Service Principal with ID %q from Microsoft Graph: %+v", objectId, err)
+	}
+
+	d.Set("login_url", msSp.LoginUrl)
+	d.Set("preferred_single_sign_on_mode", msSp.PreferredSingleSignOnMode)
+
+	if err := d.Set("notification_email_addresses", msSp.NotificationEmailAddresses); err != nil {
+		return fmt.Errorf("setting `notification_email_addresses`: %+v", err)
+	}
+
+	samlSingleSignOn := make([]map[string]interface{}, 0)
+	if msSp.SamlSingleSignOnSettings != nil {
+		relayState := ""
+		if msSp.SamlSingleSignOnSettings.RelayState != nil {
+			relayState = *msSp.SamlSingleSignOnSettings.RelayState
+		}
+
+		samlSingleSignOn = append(samlSingleSignOn, map[string]interface{}{
+			"relay_state": relayState,
+		})
+	}
+	d.Set("saml_single_sign_on", samlSingleSignOn)
+
 	return nil
 }
 
@@ -53,6 +53,50 @@ func TestAccServicePrincipal_complete(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_samlSingleSignOn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	id := uuid.New().String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipal_samlSingleSignOn(id),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckServicePrincipalExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "preferred_single_sign_on_mode", "saml"),
+					resource.TestCheckResourceAttr(data.ResourceName, "saml_single_sign_on.0.relay_state", "/accounts"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccServicePrincipal_featureTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	id := uuid.New().String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckServicePrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipal_featureTags(id),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckServicePrincipalExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "feature_tags.0.enterprise", "true"),
+					resource.TestCheckResourceAttr(data.ResourceName, "feature_tags.0.gallery", "true"),
+					resource.TestCheckResourceAttr(data.ResourceName, "feature_tags.0.hide", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServicePrincipal_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
 	id := uuid.New().String()
@@ -146,6 +190,42 @@ resource "azuread_service_principal" "test" {
 `, id)
 }
 
+func testAccServicePrincipal_samlSingleSignOn(id string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%s"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id                = azuread_application.test.application_id
+  login_url                     = "https://example.net/login"
+  notification_email_addresses  = ["alerts@example.net"]
+  preferred_single_sign_on_mode = "saml"
+
+  saml_single_sign_on {
+    relay_state = "/accounts"
+  }
+}
+`, id)
+}
+
+func testAccServicePrincipal_featureTags(id string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%s"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+
+  feature_tags {
+    enterprise = true
+    gallery    = true
+  }
+}
+`, id)
+}
+
 func testAccServicePrincipal_complete(id string) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
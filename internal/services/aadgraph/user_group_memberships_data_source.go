@@ -0,0 +1,67 @@
+package aadgraph
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func userGroupMembershipsData() *schema.Resource {
+	return &schema.Resource{
+		Read: userGroupMembershipsDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"security_enabled_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"group_object_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func userGroupMembershipsDataRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).AadGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	userId := d.Get("user_object_id").(string)
+	securityEnabledOnly := d.Get("security_enabled_only").(bool)
+
+	// getMemberGroups returns all groups the user is a member of, including
+	// those inherited through nested group membership
+	result, err := client.GetMemberGroups(ctx, userId, graphrbac.UserGetMemberGroupsParameters{
+		SecurityEnabledOnly: utils.Bool(securityEnabledOnly),
+	})
+	if err != nil {
+		return fmt.Errorf("retrieving group memberships for User with ID %q: %+v", userId, err)
+	}
+
+	groupIds := make([]string, 0)
+	if result.Value != nil {
+		groupIds = *result.Value
+	}
+
+	d.SetId(fmt.Sprintf("%s-memberGroups", userId))
+
+	if err := d.Set("group_object_ids", groupIds); err != nil {
+		return fmt.Errorf("setting `group_object_ids`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package aadgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+)
+
+func TestAccUserGroupMembershipsDataSource_basic(t *testing.T) {
+	dsn := "data.azuread_user_group_memberships.test"
+	id := tf.AccRandTimeInt()
+	password := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupMembershipsDataSource_basic(id, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "group_object_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserGroupMembershipsDataSource_basic(id int, password string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "test" {
+  name    = "acctestGroup-%[2]d"
+  members = [azuread_user.test.object_id]
+}
+
+data "azuread_user_group_memberships" "test" {
+  user_object_id        = azuread_user.test.object_id
+  security_enabled_only = true
+
+  depends_on = [azuread_group.test]
+}
+`, testAccUser_basic(id, password), id)
+}
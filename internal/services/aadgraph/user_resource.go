@@ -3,6 +3,7 @@ package aadgraph
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/aadgraph/graph"
+	msgraph "github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
@@ -70,6 +72,63 @@ func userResource() *schema.Resource {
 				Default:  false,
 			},
 
+			"force_password_change_next_sign_in_with_mfa": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"employee_hire_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"employee_leave_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"employee_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"employee_org_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cost_center": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"division": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			// keyed by the fully qualified extension property name, e.g.
+			// extension_00000000000000000000000000000000_jobGroup
+			"extension_attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"manager_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.UUID,
+			},
+
 			"mail": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -157,6 +216,30 @@ func userResourceCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("waiting for User (%s) with ObjectId %q: %+v", upn, *user.ObjectID, err)
 	}
 
+	if _, ok := d.GetOk("manager_id"); ok {
+		if err := userSetManager(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("force_password_change_next_sign_in_with_mfa").(bool) {
+		if err := userSetForcePasswordChangeWithMfa(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if userHasEmployeeInfo(d) {
+		if err := userSetEmployeeInfo(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("extension_attributes"); ok {
+		if err := userSetExtensionAttributes(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return userResourceRead(d, meta)
 }
 
@@ -197,9 +280,146 @@ func userResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("updating User with ID %q: %+v", d.Id(), err)
 	}
 
+	if d.HasChange("manager_id") {
+		if err := userSetManager(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("force_password_change_next_sign_in_with_mfa") {
+		if err := userSetForcePasswordChangeWithMfa(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("employee_hire_date") || d.HasChange("employee_leave_date") || d.HasChange("employee_type") || d.HasChange("employee_org_data") {
+		if err := userSetEmployeeInfo(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("extension_attributes") {
+		if err := userSetExtensionAttributes(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return userResourceRead(d, meta)
 }
 
+func userHasEmployeeInfo(d *schema.ResourceData) bool {
+	for _, field := range []string{"employee_hire_date", "employee_leave_date", "employee_type", "employee_org_data"} {
+		if _, ok := d.GetOk(field); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// userSetEmployeeInfo sets the employee lifecycle properties for a user, which are
+// only exposed by Microsoft Graph.
+func userSetEmployeeInfo(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	var properties msgraph.User
+
+	if v, ok := d.GetOk("employee_hire_date"); ok {
+		properties.EmployeeHireDate = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("employee_leave_date"); ok {
+		properties.EmployeeLeaveDateTime = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("employee_type"); ok {
+		properties.EmployeeType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("employee_org_data"); ok {
+		if raw := v.([]interface{}); len(raw) > 0 && raw[0] != nil {
+			orgData := raw[0].(map[string]interface{})
+			properties.EmployeeOrgData = &msgraph.EmployeeOrgData{
+				CostCenter: utils.String(orgData["cost_center"].(string)),
+				Division:   utils.String(orgData["division"].(string)),
+			}
+		}
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("setting employee information for User with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// userSetExtensionAttributes sets directory extension property values for a user,
+// which are only settable through Microsoft Graph. Attributes removed from the
+// configuration are cleared.
+func userSetExtensionAttributes(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	old, new := d.GetChange("extension_attributes")
+
+	attributes := make(map[string]interface{})
+	for k, v := range new.(map[string]interface{}) {
+		attributes[k] = v
+	}
+	for k := range old.(map[string]interface{}) {
+		if _, ok := attributes[k]; !ok {
+			attributes[k] = nil
+		}
+	}
+
+	if _, err := client.UpdateExtensionAttributes(ctx, d.Id(), attributes); err != nil {
+		return fmt.Errorf("setting extension attributes for User with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// userSetForcePasswordChangeWithMfa sets the MFA variant of the force password change
+// flag, which is not supported by AAD Graph and only settable through Microsoft Graph.
+func userSetForcePasswordChangeWithMfa(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := msgraph.User{
+		PasswordProfile: &msgraph.UserPasswordProfile{
+			ForceChangePasswordNextSignInWithMfa: utils.Bool(d.Get("force_password_change_next_sign_in_with_mfa").(bool)),
+		},
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("setting password profile for User with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// userSetManager assigns or removes the manager reference for a user. The manager
+// relationship is only exposed by Microsoft Graph.
+func userSetManager(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if managerId := d.Get("manager_id").(string); managerId != "" {
+		if _, err := client.AssignManager(ctx, d.Id(), managerId); err != nil {
+			return fmt.Errorf("assigning manager %q for User with ID %q: %+v", managerId, d.Id(), err)
+		}
+	} else {
+		if status, err := client.RemoveManager(ctx, d.Id()); err != nil {
+			if status != http.StatusNotFound {
+				return fmt.Errorf("removing manager for User with ID %q: %+v", d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func userResourceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.AadClient).AadGraph.UsersClient
 	ctx := meta.(*clients.AadClient).StopContext
@@ -228,6 +448,55 @@ func userResourceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("onpremises_sam_account_name", user.AdditionalProperties["onPremisesSamAccountName"])
 	d.Set("onpremises_user_principal_name", user.AdditionalProperties["onPremisesUserPrincipalName"])
 
+	managerId, status, err := meta.(*clients.AadClient).MsGraph.UsersClient.GetManager(ctx, objectId)
+	if err != nil && status != http.StatusNotFound {
+		return fmt.Errorf("retrieving manager for User with ID %q: %+v", objectId, err)
+	}
+	d.Set("manager_id", managerId)
+
+	msUser, _, err := meta.(*clients.AadClient).MsGraph.UsersClient.Get(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("retrieving User with ID %q from Microsoft Graph: %+v", objectId, err)
+	}
+
+	d.Set("employee_hire_date", msUser.EmployeeHireDate)
+	d.Set("employee_leave_date", msUser.EmployeeLeaveDateTime)
+	d.Set("employee_type", msUser.EmployeeType)
+
+	orgData := make([]map[string]interface{}, 0)
+	if msUser.EmployeeOrgData != nil && (msUser.EmployeeOrgData.CostCenter != nil || msUser.EmployeeOrgData.Division != nil) {
+		costCenter := ""
+		if msUser.EmployeeOrgData.CostCenter != nil {
+			costCenter = *msUser.EmployeeOrgData.CostCenter
+		}
+
+		division := ""
+		if msUser.EmployeeOrgData.Division != nil {
+			division = *msUser.EmployeeOrgData.Division
+		}
+
+		orgData = append(orgData, map[string]interface{}{
+			"cost_center": costCenter,
+			"division":    division,
+		})
+	}
+	d.Set("employee_org_data", orgData)
+
+	// only the configured attributes are read back, so that extension properties
+	// managed outside of Terraform do not show as diffs
+	if configured := d.Get("extension_attributes").(map[string]interface{}); len(configured) > 0 {
+		names := make([]string, 0, len(configured))
+		for k := range configured {
+			names = append(names, k)
+		}
+
+		values, _, err := meta.(*clients.AadClient).MsGraph.UsersClient.GetExtensionAttributes(ctx, objectId, names)
+		if err != nil {
+			return fmt.Errorf("retrieving extension attributes for User with ID %q: %+v", objectId, err)
+		}
+		d.Set("extension_attributes", values)
+	}
+
 	return nil
 }
 
@@ -26,7 +26,7 @@ func TestAccUser_basic(t *testing.T) {
 					testCheckUserExists(data.ResourceName),
 				),
 			},
-			data.ImportStep("force_password_change", "password"),
+			data.ImportStep("force_password_change", "force_password_change_next_sign_in_with_mfa", "password"),
 		},
 	})
 }
@@ -45,7 +45,7 @@ func TestAccUser_complete(t *testing.T) {
 					testCheckUserExists(data.ResourceName),
 				),
 			},
-			data.ImportStep("force_password_change", "password"),
+			data.ImportStep("force_password_change", "force_password_change_next_sign_in_with_mfa", "password"),
 		},
 	})
 }
@@ -64,14 +64,14 @@ func TestAccUser_update(t *testing.T) {
 					testCheckUserExists(data.ResourceName),
 				),
 			},
-			data.ImportStep("force_password_change", "password"),
+			data.ImportStep("force_password_change", "force_password_change_next_sign_in_with_mfa", "password"),
 			{
 				Config: testAccUser_complete(data.RandomInteger, data.RandomPassword),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckUserExists(data.ResourceName),
 				),
 			},
-			data.ImportStep("force_password_change", "password"),
+			data.ImportStep("force_password_change", "force_password_change_next_sign_in_with_mfa", "password"),
 		},
 	})
 }
@@ -101,6 +101,59 @@ func TestAccUser_threeUsersABC(t *testing.T) {
 	})
 }
 
+func TestAccUser_employeeInfo(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUser_employeeInfo(data.RandomInteger, data.RandomPassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUserExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "employee_type", "Contractor"),
+					resource.TestCheckResourceAttr(data.ResourceName, "employee_hire_date", "2026-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(data.ResourceName, "employee_leave_date", "2026-12-31T00:00:00Z"),
+					resource.TestCheckResourceAttr(data.ResourceName, "employee_org_data.0.cost_center", "CC-1234"),
+					resource.TestCheckResourceAttr(data.ResourceName, "employee_org_data.0.division", "Engineering"),
+				),
+			},
+			data.ImportStep("force_password_change", "force_password_change_next_sign_in_with_mfa", "password"),
+		},
+	})
+}
+
+func TestAccUser_manager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUser_manager(data.RandomInteger, data.RandomPassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUserExists(data.ResourceName),
+					resource.TestCheckResourceAttrPair(data.ResourceName, "manager_id", "azuread_user.manager", "object_id"),
+				),
+			},
+			data.ImportStep(),
+			// remove the manager
+			{
+				Config: testAccUser_basic(data.RandomInteger, data.RandomPassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUserExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "manager_id", ""),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
 func testCheckUserExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -176,6 +229,51 @@ resource "azuread_user" "test" {
   force_password_change = true
   usage_location        = "NO"
   immutable_id          = "%[1]d"
+
+  force_password_change_next_sign_in_with_mfa = true
+}
+`, id, password)
+}
+
+func testAccUser_employeeInfo(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  employee_type       = "Contractor"
+  employee_hire_date  = "2026-01-01T00:00:00Z"
+  employee_leave_date = "2026-12-31T00:00:00Z"
+
+  employee_org_data {
+    cost_center = "CC-1234"
+    division    = "Engineering"
+  }
+}
+`, id, password)
+}
+
+func testAccUser_manager(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "manager" {
+  user_principal_name = "acctestUser.%[1]d.Manager@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-Manager"
+  password            = "%[2]s"
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  manager_id          = azuread_user.manager.object_id
 }
 `, id, password)
 }
@@ -28,7 +28,7 @@ func usersData() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames"},
+				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "employee_ids", "return_all"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.UUID,
@@ -39,7 +39,7 @@ func usersData() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames"},
+				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "employee_ids", "return_all"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.NoEmptyStrings,
@@ -50,7 +50,18 @@ func usersData() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames"},
+				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "employee_ids", "return_all"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"employee_ids": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "employee_ids", "return_all"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.NoEmptyStrings,
@@ -58,9 +69,18 @@ func usersData() *schema.Resource {
 			},
 
 			"ignore_missing": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"return_all"},
+			},
+
+			"return_all": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"ignore_missing"},
+				ExactlyOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "employee_ids", "return_all"},
 			},
 
 			"users": {
@@ -78,6 +98,11 @@ func usersData() *schema.Resource {
 							Computed: true,
 						},
 
+						"employee_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
 						"immutable_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -132,7 +157,21 @@ func usersDataRead(d *schema.ResourceData, meta interface{}) error {
 	expectedCount := 0
 
 	ignoreMissing := d.Get("ignore_missing").(bool)
-	if upns, ok := d.Get("user_principal_names").([]interface{}); ok && len(upns) > 0 {
+	returnAll := d.Get("return_all").(bool)
+	if returnAll {
+		resp, err := client.ListComplete(ctx, "", "")
+		if err != nil {
+			return fmt.Errorf("listing Azure AD Users: %+v", err)
+		}
+		for resp.NotDone() {
+			u := resp.Value()
+			users = append(users, &u)
+			if err := resp.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Azure AD Users: %+v", err)
+			}
+		}
+		expectedCount = len(users)
+	} else if upns, ok := d.Get("user_principal_names").([]interface{}); ok && len(upns) > 0 {
 		expectedCount = len(upns)
 		for _, v := range upns {
 			u, err := client.Get(ctx, v.(string))
@@ -177,6 +216,22 @@ func usersDataRead(d *schema.ResourceData, meta interface{}) error {
 				}
 				users = append(users, u)
 			}
+		} else if employeeIds, ok := d.Get("employee_ids").([]interface{}); ok && len(employeeIds) > 0 {
+			expectedCount = len(employeeIds)
+			for _, v := range employeeIds {
+				u, err := graph.UserGetByEmployeeId(ctx, client, v.(string))
+				if err != nil {
+					return fmt.Errorf("finding User with employee ID %q: %+v", v.(string), err)
+				}
+				if u == nil {
+					if ignoreMissing {
+						continue
+					} else {
+						return fmt.Errorf("found no Users with employee ID %q", v.(string))
+					}
+				}
+				users = append(users, u)
+			}
 		}
 	}
 
@@ -187,6 +242,7 @@ func usersDataRead(d *schema.ResourceData, meta interface{}) error {
 	upns := make([]string, 0, len(users))
 	oids := make([]string, 0, len(users))
 	mailNicknames := make([]string, 0, len(users))
+	employeeIds := make([]string, 0, len(users))
 	userList := make([]map[string]interface{}, 0, len(users))
 	for _, u := range users {
 		if u.ObjectID == nil || u.UserPrincipalName == nil {
@@ -198,10 +254,14 @@ func usersDataRead(d *schema.ResourceData, meta interface{}) error {
 		if u.MailNickname != nil {
 			mailNicknames = append(mailNicknames, *u.MailNickname)
 		}
+		if employeeId, ok := u.AdditionalProperties["employeeId"].(string); ok {
+			employeeIds = append(employeeIds, employeeId)
+		}
 
 		user := make(map[string]interface{})
 		user["account_enabled"] = u.AccountEnabled
 		user["display_name"] = u.DisplayName
+		user["employee_id"] = u.AdditionalProperties["employeeId"]
 		user["immutable_id"] = u.ImmutableID
 		user["mail"] = u.Mail
 		user["mail_nickname"] = u.MailNickname
@@ -222,6 +282,7 @@ func usersDataRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("object_ids", oids)
 	d.Set("user_principal_names", upns)
 	d.Set("mail_nicknames", mailNicknames)
+	d.Set("employee_ids", employeeIds)
 	d.Set("users", userList)
 
 	return nil
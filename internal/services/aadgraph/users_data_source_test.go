@@ -2,6 +2,7 @@ package aadgraph_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -132,6 +133,25 @@ func TestAccUsersDataSource_byMailNicknamesIgnoreMissing(t *testing.T) {
 	})
 }
 
+func TestAccUsersDataSource_returnAll(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSource_returnAll(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(data.ResourceName, "user_principal_names.#", regexp.MustCompile("^[1-9]")),
+					resource.TestMatchResourceAttr(data.ResourceName, "object_ids.#", regexp.MustCompile("^[1-9]")),
+					resource.TestMatchResourceAttr(data.ResourceName, "users.#", regexp.MustCompile("^[1-9]")),
+				),
+			},
+		},
+	})
+}
+
 func TestAccUsersDataSource_noNames(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
 
@@ -230,6 +250,14 @@ data "azuread_users" "test" {
 `, testAccUser_threeUsersABC(id, password), id)
 }
 
+func testAccUsersDataSource_returnAll() string {
+	return `
+data "azuread_users" "test" {
+  return_all = true
+}
+`
+}
+
 func testAccUsersDataSource_noNames() string {
 	return `
 data "azuread_users" "test" {
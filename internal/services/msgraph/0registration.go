@@ -0,0 +1,103 @@
+package msgraph
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "MS Graph"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"MS Graph",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azuread_access_package":              accessPackageData(),
+		"azuread_access_package_catalog":      accessPackageCatalogData(),
+		"azuread_conditional_access_policies": conditionalAccessPoliciesData(),
+		"azuread_conditional_access_policy":   conditionalAccessPolicyData(),
+		"azuread_deleted_items":               deletedItemsData(),
+		"azuread_device":                      deviceData(),
+		"azuread_directory_role_templates":    directoryRoleTemplatesData(),
+		"azuread_directory_roles":             directoryRolesData(),
+		"azuread_named_location":              namedLocationData(),
+		"azuread_subscribed_skus":             subscribedSkusData(),
+	}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azuread_access_package":                                         accessPackageResource(),
+		"azuread_access_package_assignment":                              accessPackageAssignmentResource(),
+		"azuread_access_package_assignment_policy":                       accessPackageAssignmentPolicyResource(),
+		"azuread_access_package_catalog":                                 accessPackageCatalogResource(),
+		"azuread_access_package_catalog_role_assignment":                 accessPackageCatalogRoleAssignmentResource(),
+		"azuread_access_package_resource_catalog_association":            accessPackageResourceCatalogAssociationResource(),
+		"azuread_access_package_resource_package_association":            accessPackageResourcePackageAssociationResource(),
+		"azuread_access_review_schedule_definition":                      accessReviewScheduleDefinitionResource(),
+		"azuread_admin_consent_request_policy":                           adminConsentRequestPolicyResource(),
+		"azuread_administrative_unit":                                    administrativeUnitResource(),
+		"azuread_app_role_assignment":                                    appRoleAssignmentResource(),
+		"azuread_administrative_unit_member":                             administrativeUnitMemberResource(),
+		"azuread_administrative_unit_role_member":                        administrativeUnitRoleMemberResource(),
+		"azuread_app_management_policy":                                  appManagementPolicyResource(),
+		"azuread_application_extension_property":                         applicationExtensionPropertyResource(),
+		"azuread_application_federated_identity_credential":              applicationFederatedIdentityCredentialResource(),
+		"azuread_application_from_template":                              applicationFromTemplateResource(),
+		"azuread_application_pre_authorized":                             applicationPreAuthorizedResource(),
+		"azuread_application_redirect_uris":                              applicationRedirectUrisResource(),
+		"azuread_application_verified_publisher":                         applicationVerifiedPublisherResource(),
+		"azuread_attribute_set":                                          attributeSetResource(),
+		"azuread_authentication_context_class_reference":                 authenticationContextClassReferenceResource(),
+		"azuread_authentication_methods_policy_settings":                 authenticationMethodsPolicySettingsResource(),
+		"azuread_authentication_strength_policy":                         authenticationStrengthPolicyResource(),
+		"azuread_authorization_policy":                                   authorizationPolicyResource(),
+		"azuread_b2c_identity_provider":                                  b2cIdentityProviderResource(),
+		"azuread_b2c_user_flow":                                          b2cUserFlowResource(),
+		"azuread_country_named_location":                                 countryNamedLocationResource(),
+		"azuread_cross_tenant_access_policy":                             crossTenantAccessPolicyResource(),
+		"azuread_cross_tenant_access_policy_partner":                     crossTenantAccessPolicyPartnerResource(),
+		"azuread_cross_tenant_synchronization_configuration":             crossTenantSynchronizationConfigurationResource(),
+		"azuread_custom_directory_role":                                  customDirectoryRoleResource(),
+		"azuread_custom_domain":                                          customDomainResource(),
+		"azuread_custom_domain_verification":                             customDomainVerificationResource(),
+		"azuread_custom_security_attribute_definition":                   customSecurityAttributeDefinitionResource(),
+		"azuread_device":                                                 deviceResource(),
+		"azuread_directory_role":                                         directoryRoleResource(),
+		"azuread_directory_role_assignment":                              directoryRoleAssignmentResource(),
+		"azuread_directory_role_eligibility_schedule_request":            directoryRoleEligibilityScheduleRequestResource(),
+		"azuread_directory_role_member":                                  directoryRoleMemberResource(),
+		"azuread_directory_setting":                                      directorySettingResource(),
+		"azuread_external_identity_provider":                             externalIdentityProviderResource(),
+		"azuread_group_lifecycle_policy":                                 groupLifecyclePolicyResource(),
+		"azuread_group_lifecycle_policy_group":                           groupLifecyclePolicyGroupResource(),
+		"azuread_group_members":                                          groupMembersResource(),
+		"azuread_group_photo":                                            groupPhotoResource(),
+		"azuread_invitation":                                             invitationResource(),
+		"azuread_named_location":                                         namedLocationResource(),
+		"azuread_organization_branding":                                  organizationBrandingResource(),
+		"azuread_permission_grant_policy":                                permissionGrantPolicyResource(),
+		"azuread_privileged_access_group_assignment_schedule":            privilegedAccessGroupAssignmentScheduleResource(),
+		"azuread_privileged_access_group_eligibility_schedule":           privilegedAccessGroupEligibilityScheduleResource(),
+		"azuread_schema_extension":                                       schemaExtensionResource(),
+		"azuread_service_principal_custom_security_attribute_assignment": servicePrincipalCustomSecurityAttributeAssignmentResource(),
+		"azuread_service_principal_policy_assignment":                    servicePrincipalPolicyAssignmentResource(),
+		"azuread_synchronization_job":                                    synchronizationJobResource(),
+		"azuread_synchronization_job_provision_on_demand":                synchronizationJobProvisionOnDemandResource(),
+		"azuread_synchronization_secret":                                 synchronizationSecretResource(),
+		"azuread_tenant_app_management_policy":                           tenantAppManagementPolicyResource(),
+		"azuread_token_issuance_policy":                                  tokenIssuancePolicyResource(),
+		"azuread_user_authentication_method_phone":                       userAuthenticationMethodPhoneResource(),
+		"azuread_user_custom_security_attribute_assignment":              userCustomSecurityAttributeAssignmentResource(),
+	}
+}
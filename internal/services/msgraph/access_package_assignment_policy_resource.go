@@ -0,0 +1,573 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageAssignmentPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: accessPackageAssignmentPolicyResourceCreate,
+		Read:   accessPackageAssignmentPolicyResourceRead,
+		Update: accessPackageAssignmentPolicyResourceUpdate,
+		Delete: accessPackageAssignmentPolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"access_package_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"duration_in_days": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"expiration_date"},
+				ValidateFunc:  validation.IntBetween(1, 3660),
+			},
+
+			"expiration_date": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"duration_in_days"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"extension_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"requestor_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "NoSubjects",
+							ValidateFunc: validation.StringInSlice([]string{
+								"AllConfiguredConnectedOrganizationSubjects",
+								"AllExistingConnectedOrganizationSubjects",
+								"AllExistingDirectoryMemberUsers",
+								"AllExistingDirectorySubjects",
+								"AllExternalSubjects",
+								"NoSubjects",
+								"SpecificConnectedOrganizationSubjects",
+								"SpecificDirectorySubjects",
+							}, false),
+						},
+
+						"requests_accepted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"approval_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"approval_required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"requestor_justification_required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"approval_stage": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"approval_timeout_in_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 14),
+									},
+
+									"approver_justification_required": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"primary_approver": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"object_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.UUID,
+												},
+
+												"subject_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  "singleUser",
+													ValidateFunc: validation.StringInSlice([]string{
+														"groupMembers",
+														"singleUser",
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"assignment_review_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"review_frequency": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"annual",
+								"halfyearly",
+								"monthly",
+								"quarterly",
+								"weekly",
+							}, false),
+						},
+
+						"duration_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 365),
+						},
+
+						"starting_on": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+
+						"reviewer_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Self",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Reviewers",
+								"Self",
+							}, false),
+						},
+
+						"reviewer": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.UUID,
+									},
+
+									"subject_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "singleUser",
+										ValidateFunc: validation.StringInSlice([]string{
+											"groupMembers",
+											"singleUser",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessPackageAssignmentPolicyResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageAssignmentPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	properties := expandAccessPackageAssignmentPolicy(d)
+	properties.AccessPackageId = utils.String(d.Get("access_package_id").(string))
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Access Package Assignment Policy %q: %+v", displayName, err)
+	}
+	if policy.ID == nil || *policy.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Access Package Assignment Policy %q", displayName)
+	}
+
+	d.SetId(*policy.ID)
+
+	return accessPackageAssignmentPolicyResourceRead(d, meta)
+}
+
+func accessPackageAssignmentPolicyResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageAssignmentPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := expandAccessPackageAssignmentPolicy(d)
+	properties.ID = utils.String(d.Id())
+	properties.AccessPackageId = utils.String(d.Get("access_package_id").(string))
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Access Package Assignment Policy with ID %q: %+v", d.Id(), err)
+	}
+
+	return accessPackageAssignmentPolicyResourceRead(d, meta)
+}
+
+func accessPackageAssignmentPolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageAssignmentPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access Package Assignment Policy with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Access Package Assignment Policy with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("access_package_id", policy.AccessPackageId)
+	d.Set("display_name", policy.DisplayName)
+	d.Set("description", policy.Description)
+	d.Set("extension_enabled", policy.CanExtend)
+	d.Set("expiration_date", policy.ExpirationDateTime)
+
+	durationInDays := 0
+	if policy.DurationInDays != nil {
+		durationInDays = int(*policy.DurationInDays)
+	}
+	d.Set("duration_in_days", durationInDays)
+
+	d.Set("requestor_settings", flattenRequestorSettings(policy.RequestorSettings))
+	d.Set("approval_settings", flattenApprovalSettings(policy.RequestApprovalSettings))
+	d.Set("assignment_review_settings", flattenAssignmentReviewSettings(policy.AccessReviewSettings))
+
+	return nil
+}
+
+func accessPackageAssignmentPolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageAssignmentPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Access Package Assignment Policy with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandAccessPackageAssignmentPolicy(d *schema.ResourceData) graph.AccessPackageAssignmentPolicy {
+	properties := graph.AccessPackageAssignmentPolicy{
+		DisplayName:             utils.String(d.Get("display_name").(string)),
+		Description:             utils.String(d.Get("description").(string)),
+		CanExtend:               utils.Bool(d.Get("extension_enabled").(bool)),
+		RequestorSettings:       expandRequestorSettings(d.Get("requestor_settings").([]interface{})),
+		RequestApprovalSettings: expandApprovalSettings(d.Get("approval_settings").([]interface{})),
+		AccessReviewSettings:    expandAssignmentReviewSettings(d.Get("assignment_review_settings").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("duration_in_days"); ok {
+		properties.DurationInDays = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		properties.ExpirationDateTime = utils.String(v.(string))
+	}
+
+	return properties
+}
+
+func expandUserSets(in []interface{}) *[]graph.UserSet {
+	userSets := make([]graph.UserSet, 0)
+	for _, raw := range in {
+		subject := raw.(map[string]interface{})
+		userSets = append(userSets, graph.UserSet{
+			ODataType: utils.String("#microsoft.graph." + subject["subject_type"].(string)),
+			ID:        utils.String(subject["object_id"].(string)),
+		})
+	}
+
+	return &userSets
+}
+
+func flattenUserSets(in *[]graph.UserSet) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, userSet := range *in {
+		objectId := ""
+		if userSet.ID != nil {
+			objectId = *userSet.ID
+		}
+
+		subjectType := ""
+		if userSet.ODataType != nil {
+			subjectType = strings.TrimPrefix(*userSet.ODataType, "#microsoft.graph.")
+		}
+
+		result = append(result, map[string]interface{}{
+			"object_id":    objectId,
+			"subject_type": subjectType,
+		})
+	}
+
+	return result
+}
+
+func expandRequestorSettings(in []interface{}) *graph.RequestorSettings {
+	result := graph.RequestorSettings{
+		ScopeType:      utils.String("NoSubjects"),
+		AcceptRequests: utils.Bool(false),
+	}
+
+	if len(in) > 0 && in[0] != nil {
+		settings := in[0].(map[string]interface{})
+		result.ScopeType = utils.String(settings["scope_type"].(string))
+		result.AcceptRequests = utils.Bool(settings["requests_accepted"].(bool))
+	}
+
+	return &result
+}
+
+func flattenRequestorSettings(in *graph.RequestorSettings) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	scopeType := ""
+	if in.ScopeType != nil {
+		scopeType = *in.ScopeType
+	}
+
+	acceptRequests := false
+	if in.AcceptRequests != nil {
+		acceptRequests = *in.AcceptRequests
+	}
+
+	return append(result, map[string]interface{}{
+		"scope_type":        scopeType,
+		"requests_accepted": acceptRequests,
+	})
+}
+
+func expandApprovalSettings(in []interface{}) *graph.ApprovalSettings {
+	result := graph.ApprovalSettings{
+		IsApprovalRequired:               utils.Bool(false),
+		IsRequestorJustificationRequired: utils.Bool(false),
+		ApprovalMode:                     utils.String("NoApproval"),
+	}
+
+	if len(in) > 0 && in[0] != nil {
+		settings := in[0].(map[string]interface{})
+		result.IsApprovalRequired = utils.Bool(settings["approval_required"].(bool))
+		result.IsRequestorJustificationRequired = utils.Bool(settings["requestor_justification_required"].(bool))
+
+		stages := make([]graph.ApprovalStage, 0)
+		for _, rawStage := range settings["approval_stage"].([]interface{}) {
+			stage := rawStage.(map[string]interface{})
+			stages = append(stages, graph.ApprovalStage{
+				ApprovalStageTimeOutInDays:      utils.Int32(int32(stage["approval_timeout_in_days"].(int))),
+				IsApproverJustificationRequired: utils.Bool(stage["approver_justification_required"].(bool)),
+				IsEscalationEnabled:             utils.Bool(false),
+				PrimaryApprovers:                expandUserSets(stage["primary_approver"].([]interface{})),
+			})
+		}
+
+		if len(stages) > 0 {
+			result.ApprovalMode = utils.String("SingleStage")
+			if len(stages) > 1 {
+				result.ApprovalMode = utils.String("Serial")
+			}
+			result.ApprovalStages = &stages
+		}
+	}
+
+	return &result
+}
+
+func flattenApprovalSettings(in *graph.ApprovalSettings) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	approvalRequired := false
+	if in.IsApprovalRequired != nil {
+		approvalRequired = *in.IsApprovalRequired
+	}
+
+	requestorJustificationRequired := false
+	if in.IsRequestorJustificationRequired != nil {
+		requestorJustificationRequired = *in.IsRequestorJustificationRequired
+	}
+
+	stages := make([]map[string]interface{}, 0)
+	if in.ApprovalStages != nil {
+		for _, stage := range *in.ApprovalStages {
+			approvalTimeout := 0
+			if stage.ApprovalStageTimeOutInDays != nil {
+				approvalTimeout = int(*stage.ApprovalStageTimeOutInDays)
+			}
+
+			approverJustificationRequired := false
+			if stage.IsApproverJustificationRequired != nil {
+				approverJustificationRequired = *stage.IsApproverJustificationRequired
+			}
+
+			stages = append(stages, map[string]interface{}{
+				"approval_timeout_in_days":        approvalTimeout,
+				"approver_justification_required": approverJustificationRequired,
+				"primary_approver":                flattenUserSets(stage.PrimaryApprovers),
+			})
+		}
+	}
+
+	return append(result, map[string]interface{}{
+		"approval_required":                approvalRequired,
+		"requestor_justification_required": requestorJustificationRequired,
+		"approval_stage":                   stages,
+	})
+}
+
+func expandAssignmentReviewSettings(in []interface{}) *graph.AssignmentReviewSettings {
+	result := graph.AssignmentReviewSettings{
+		IsEnabled: utils.Bool(false),
+	}
+
+	if len(in) > 0 && in[0] != nil {
+		settings := in[0].(map[string]interface{})
+		result.IsEnabled = utils.Bool(settings["enabled"].(bool))
+		result.ReviewerType = utils.String(settings["reviewer_type"].(string))
+		result.Reviewers = expandUserSets(settings["reviewer"].([]interface{}))
+
+		if v := settings["review_frequency"].(string); v != "" {
+			result.RecurrenceType = utils.String(v)
+		}
+
+		if v := settings["duration_in_days"].(int); v > 0 {
+			result.DurationInDays = utils.Int32(int32(v))
+		}
+
+		if v := settings["starting_on"].(string); v != "" {
+			result.StartDateTime = utils.String(v)
+		}
+	}
+
+	return &result
+}
+
+func flattenAssignmentReviewSettings(in *graph.AssignmentReviewSettings) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	enabled := false
+	if in.IsEnabled != nil {
+		enabled = *in.IsEnabled
+	}
+
+	reviewFrequency := ""
+	if in.RecurrenceType != nil {
+		reviewFrequency = *in.RecurrenceType
+	}
+
+	reviewerType := ""
+	if in.ReviewerType != nil {
+		reviewerType = *in.ReviewerType
+	}
+
+	durationInDays := 0
+	if in.DurationInDays != nil {
+		durationInDays = int(*in.DurationInDays)
+	}
+
+	startingOn := ""
+	if in.StartDateTime != nil {
+		startingOn = *in.StartDateTime
+	}
+
+	return append(result, map[string]interface{}{
+		"enabled":          enabled,
+		"review_frequency": reviewFrequency,
+		"reviewer_type":    reviewerType,
+		"duration_in_days": durationInDays,
+		"starting_on":      startingOn,
+		"reviewer":         flattenUserSets(in.Reviewers),
+	})
+}
@@ -0,0 +1,186 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAccessPackageAssignmentPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageAssignmentPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageAssignmentPolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageAssignmentPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestAPAP-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "duration_in_days", "90"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAccessPackageAssignmentPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageAssignmentPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageAssignmentPolicy_complete(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageAssignmentPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "requestor_settings.0.scope_type", "AllExistingDirectoryMemberUsers"),
+					resource.TestCheckResourceAttr(data.ResourceName, "approval_settings.0.approval_required", "true"),
+					resource.TestCheckResourceAttr(data.ResourceName, "approval_settings.0.approval_stage.0.primary_approver.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "assignment_review_settings.0.enabled", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAccessPackageAssignmentPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackageAssignmentPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Access Package Assignment Policy %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Access Package Assignment Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAccessPackageAssignmentPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_access_package_assignment_policy" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackageAssignmentPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		policy, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Access Package Assignment Policy still exists:\n%#v", policy)
+	}
+
+	return nil
+}
+
+func testAccAccessPackageAssignmentPolicy_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctestAP-%[1]d"
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  access_package_id = azuread_access_package.test.id
+  display_name      = "acctestAPAP-%[1]d"
+  duration_in_days  = 90
+}
+`, id)
+}
+
+func testAccAccessPackageAssignmentPolicy_complete(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "approver" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctestAP-%[1]d"
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  access_package_id = azuread_access_package.test.id
+  display_name      = "acctestAPAP-%[1]d"
+  description       = "Acceptance test assignment policy"
+  duration_in_days  = 30
+  extension_enabled = true
+
+  requestor_settings {
+    scope_type        = "AllExistingDirectoryMemberUsers"
+    requests_accepted = true
+  }
+
+  approval_settings {
+    approval_required                = true
+    requestor_justification_required = true
+
+    approval_stage {
+      approval_timeout_in_days        = 14
+      approver_justification_required = true
+
+      primary_approver {
+        object_id    = azuread_user.approver.id
+        subject_type = "singleUser"
+      }
+    }
+  }
+
+  assignment_review_settings {
+    enabled          = true
+    review_frequency = "quarterly"
+    duration_in_days = 3
+    reviewer_type    = "Reviewers"
+
+    reviewer {
+      object_id    = azuread_user.approver.id
+      subject_type = "singleUser"
+    }
+  }
+}
+`, id, password)
+}
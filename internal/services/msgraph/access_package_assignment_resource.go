@@ -0,0 +1,167 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: accessPackageAssignmentResourceCreate,
+		Read:   accessPackageAssignmentResourceRead,
+		Delete: accessPackageAssignmentResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID is blank")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"access_package_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"assignment_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"expiration_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func accessPackageAssignmentResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageAssignmentRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	accessPackageId := d.Get("access_package_id").(string)
+
+	assignment := graph.AccessPackageAssignment{
+		AccessPackageId:    utils.String(accessPackageId),
+		AssignmentPolicyId: utils.String(d.Get("assignment_policy_id").(string)),
+		TargetId:           utils.String(d.Get("principal_object_id").(string)),
+	}
+
+	if endDateTime, ok := d.GetOk("expiration_date"); ok {
+		assignment.Schedule = &graph.RequestSchedule{
+			Expiration: &graph.ExpirationPattern{
+				EndDateTime: utils.String(endDateTime.(string)),
+				Type:        utils.String("afterDateTime"),
+			},
+		}
+	}
+
+	properties := graph.AccessPackageAssignmentRequest{
+		RequestType:             utils.String("AdminAdd"),
+		AccessPackageAssignment: &assignment,
+	}
+
+	request, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating assignment request for Access Package with ID %q: %+v", accessPackageId, err)
+	}
+	if request.ID == nil || *request.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for assignment request on Access Package with ID %q", accessPackageId)
+	}
+
+	d.SetId(*request.ID)
+
+	return accessPackageAssignmentResourceRead(d, meta)
+}
+
+func accessPackageAssignmentResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageAssignmentRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	request, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access Package assignment request with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Access Package assignment request with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("state", request.RequestState)
+
+	if assignment := request.AccessPackageAssignment; assignment != nil {
+		d.Set("access_package_id", assignment.AccessPackageId)
+		d.Set("assignment_policy_id", assignment.AssignmentPolicyId)
+		d.Set("principal_object_id", assignment.TargetId)
+
+		if assignment.Schedule != nil && assignment.Schedule.Expiration != nil {
+			d.Set("expiration_date", assignment.Schedule.Expiration.EndDateTime)
+		}
+	}
+
+	return nil
+}
+
+func accessPackageAssignmentResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageAssignmentRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	request, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Access Package assignment request with ID %q: %+v", d.Id(), err)
+	}
+
+	if request.AccessPackageAssignment == nil || request.AccessPackageAssignment.ID == nil {
+		return fmt.Errorf("nil assignment returned for assignment request with ID %q", d.Id())
+	}
+
+	// assignments are revoked by submitting an adminRemove request for the assignment
+	properties := graph.AccessPackageAssignmentRequest{
+		RequestType: utils.String("AdminRemove"),
+		AccessPackageAssignment: &graph.AccessPackageAssignment{
+			ID: request.AccessPackageAssignment.ID,
+		},
+	}
+
+	if _, _, err := client.Create(ctx, properties); err != nil {
+		return fmt.Errorf("revoking Access Package assignment with ID %q: %+v", *request.AccessPackageAssignment.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAccessPackageAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageAssignment_basic(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageAssignmentExists(data.ResourceName),
+					resource.TestCheckResourceAttrPair(data.ResourceName, "principal_object_id", "azuread_user.test", "object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "state"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAccessPackageAssignmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackageAssignmentRequestsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Access Package assignment request %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Access Package assignment request %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAccessPackageAssignmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_access_package_assignment" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackageAssignmentRequestsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		request, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		if request.AccessPackageAssignment != nil && request.AccessPackageAssignment.AssignmentState != nil &&
+			*request.AccessPackageAssignment.AssignmentState == "Expired" {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func testAccAccessPackageAssignment_basic(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctestAP-%[1]d"
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  access_package_id = azuread_access_package.test.id
+  display_name      = "acctestAPAP-%[1]d"
+  duration_in_days  = 30
+}
+
+resource "azuread_access_package_assignment" "test" {
+  access_package_id    = azuread_access_package.test.id
+  assignment_policy_id = azuread_access_package_assignment_policy.test.id
+  principal_object_id  = azuread_user.test.id
+}
+`, id, password)
+}
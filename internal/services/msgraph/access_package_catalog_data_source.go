@@ -0,0 +1,112 @@
+package msgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageCatalogData() *schema.Resource {
+	return &schema.Resource{
+		Read: accessPackageCatalogDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "object_id"},
+				ValidateFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "object_id"},
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"externally_visible": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"published": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func accessPackageCatalogDataRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	var catalog *graph.AccessPackageCatalog
+
+	if objectId, ok := d.Get("object_id").(string); ok && objectId != "" {
+		resp, _, err := client.Get(ctx, objectId)
+		if err != nil {
+			return fmt.Errorf("retrieving Access Package Catalog with ID %q: %+v", objectId, err)
+		}
+		catalog = resp
+	} else {
+		displayName := d.Get("display_name").(string)
+
+		catalogs, err := client.List(ctx)
+		if err != nil {
+			return fmt.Errorf("listing Access Package Catalogs: %+v", err)
+		}
+
+		var matches []graph.AccessPackageCatalog
+		for _, v := range catalogs {
+			if v.DisplayName != nil && strings.EqualFold(*v.DisplayName, displayName) {
+				matches = append(matches, v)
+			}
+		}
+
+		if len(matches) == 0 {
+			return fmt.Errorf("found no Access Package Catalog with display name %q", displayName)
+		}
+		if len(matches) > 1 {
+			return fmt.Errorf("found multiple Access Package Catalogs with display name %q", displayName)
+		}
+
+		catalog = &matches[0]
+	}
+
+	if catalog.ID == nil {
+		return fmt.Errorf("Access Package Catalog returned with nil ID")
+	}
+
+	d.SetId(*catalog.ID)
+	d.Set("object_id", catalog.ID)
+	d.Set("display_name", catalog.DisplayName)
+	d.Set("description", catalog.Description)
+
+	externallyVisible := false
+	if catalog.IsExternallyVisible != nil {
+		externallyVisible = *catalog.IsExternallyVisible
+	}
+	d.Set("externally_visible", externallyVisible)
+
+	published := false
+	if catalog.CatalogStatus != nil {
+		published = strings.EqualFold(*catalog.CatalogStatus, "Published")
+	}
+	d.Set("published", published)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccAccessPackageCatalogDataSource_byObjectId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package_catalog", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageCatalogDataSource_byObjectId(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestAPC-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "published"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessPackageCatalogDataSource_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package_catalog", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageCatalogDataSource_byDisplayName(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestAPC-%d", data.RandomInteger)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessPackageCatalogDataSource_byObjectId(id int) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuread_access_package_catalog" "test" {
+  object_id = azuread_access_package_catalog.test.id
+}
+`, testAccAccessPackageCatalog_basic(id))
+}
+
+func testAccAccessPackageCatalogDataSource_byDisplayName(id int) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuread_access_package_catalog" "test" {
+  display_name = azuread_access_package_catalog.test.display_name
+}
+`, testAccAccessPackageCatalog_basic(id))
+}
@@ -0,0 +1,128 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageCatalogResource() *schema.Resource {
+	return &schema.Resource{
+		Create: accessPackageCatalogResourceCreate,
+		Read:   accessPackageCatalogResourceRead,
+		Update: accessPackageCatalogResourceUpdate,
+		Delete: accessPackageCatalogResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"externally_visible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func accessPackageCatalogResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	properties := graph.AccessPackageCatalog{
+		DisplayName:         utils.String(displayName),
+		Description:         utils.String(d.Get("description").(string)),
+		IsExternallyVisible: utils.Bool(d.Get("externally_visible").(bool)),
+	}
+
+	catalog, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Access Package Catalog %q: %+v", displayName, err)
+	}
+	if catalog.ID == nil || *catalog.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Access Package Catalog %q", displayName)
+	}
+
+	d.SetId(*catalog.ID)
+
+	return accessPackageCatalogResourceRead(d, meta)
+}
+
+func accessPackageCatalogResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AccessPackageCatalog{
+		DisplayName:         utils.String(d.Get("display_name").(string)),
+		Description:         utils.String(d.Get("description").(string)),
+		IsExternallyVisible: utils.Bool(d.Get("externally_visible").(bool)),
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Access Package Catalog with ID %q: %+v", d.Id(), err)
+	}
+
+	return accessPackageCatalogResourceRead(d, meta)
+}
+
+func accessPackageCatalogResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	catalog, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access Package Catalog with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Access Package Catalog with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("display_name", catalog.DisplayName)
+	d.Set("description", catalog.Description)
+	d.Set("externally_visible", catalog.IsExternallyVisible)
+
+	return nil
+}
+
+func accessPackageCatalogResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Access Package Catalog with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
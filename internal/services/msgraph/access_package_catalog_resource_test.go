@@ -0,0 +1,124 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAccessPackageCatalog_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_catalog", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageCatalogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageCatalog_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageCatalogExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestAPC-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "externally_visible", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAccessPackageCatalog_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_catalog", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageCatalogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageCatalog_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageCatalogExists(data.ResourceName),
+				),
+			},
+			{
+				Config: testAccAccessPackageCatalog_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageCatalogExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "description", "Acceptance test catalog"),
+					resource.TestCheckResourceAttr(data.ResourceName, "externally_visible", "false"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAccessPackageCatalogExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Access Package Catalog %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Access Package Catalog %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAccessPackageCatalogDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_access_package_catalog" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		catalog, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Access Package Catalog still exists:\n%#v", catalog)
+	}
+
+	return nil
+}
+
+func testAccAccessPackageCatalog_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%d"
+}
+`, id)
+}
+
+func testAccAccessPackageCatalog_complete(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name       = "acctestAPC-%d"
+  description        = "Acceptance test catalog"
+  externally_visible = false
+}
+`, id)
+}
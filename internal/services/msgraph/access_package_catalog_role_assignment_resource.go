@@ -0,0 +1,117 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageCatalogRoleAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: accessPackageCatalogRoleAssignmentResourceCreate,
+		Read:   accessPackageCatalogRoleAssignmentResourceRead,
+		Delete: accessPackageCatalogRoleAssignmentResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID is blank")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func accessPackageCatalogRoleAssignmentResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.EntitlementRoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	catalogId := d.Get("catalog_id").(string)
+
+	properties := graph.UnifiedRoleAssignment{
+		AppScopeId:       utils.String(fmt.Sprintf("/AccessPackageCatalog/%s", catalogId)),
+		PrincipalId:      utils.String(d.Get("principal_object_id").(string)),
+		RoleDefinitionId: utils.String(d.Get("role_id").(string)),
+	}
+
+	roleAssignment, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating role assignment for Access Package Catalog with ID %q: %+v", catalogId, err)
+	}
+	if roleAssignment.ID == nil || *roleAssignment.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for role assignment on Access Package Catalog with ID %q", catalogId)
+	}
+
+	d.SetId(*roleAssignment.ID)
+
+	return accessPackageCatalogRoleAssignmentResourceRead(d, meta)
+}
+
+func accessPackageCatalogRoleAssignmentResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.EntitlementRoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	roleAssignment, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access Package Catalog role assignment with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Access Package Catalog role assignment with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("role_id", roleAssignment.RoleDefinitionId)
+	d.Set("principal_object_id", roleAssignment.PrincipalId)
+
+	if roleAssignment.AppScopeId != nil {
+		d.Set("catalog_id", strings.TrimPrefix(*roleAssignment.AppScopeId, "/AccessPackageCatalog/"))
+	}
+
+	return nil
+}
+
+func accessPackageCatalogRoleAssignmentResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.EntitlementRoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Access Package Catalog role assignment with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
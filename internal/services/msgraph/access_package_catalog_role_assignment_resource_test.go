@@ -0,0 +1,108 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+// catalogOwnerRoleId is the template ID of the built-in Catalog owner role
+const catalogOwnerRoleId = "ae79f266-94d4-4dab-b730-feca7e132178"
+
+func TestAccAccessPackageCatalogRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_catalog_role_assignment", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageCatalogRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageCatalogRoleAssignment_basic(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageCatalogRoleAssignmentExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "role_id", catalogOwnerRoleId),
+					resource.TestCheckResourceAttrPair(data.ResourceName, "catalog_id", "azuread_access_package_catalog.test", "id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAccessPackageCatalogRoleAssignmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.EntitlementRoleAssignmentsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Access Package Catalog role assignment %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Access Package Catalog role assignment %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAccessPackageCatalogRoleAssignmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_access_package_catalog_role_assignment" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.EntitlementRoleAssignmentsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		roleAssignment, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Access Package Catalog role assignment still exists:\n%#v", roleAssignment)
+	}
+
+	return nil
+}
+
+func testAccAccessPackageCatalogRoleAssignment_basic(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%[1]d"
+}
+
+resource "azuread_access_package_catalog_role_assignment" "test" {
+  role_id             = "%[3]s"
+  principal_object_id = azuread_user.test.id
+  catalog_id          = azuread_access_package_catalog.test.id
+}
+`, id, password, catalogOwnerRoleId)
+}
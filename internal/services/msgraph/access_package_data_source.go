@@ -0,0 +1,114 @@
+package msgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageData() *schema.Resource {
+	return &schema.Resource{
+		Read: accessPackageDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "object_id"},
+				ValidateFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "object_id"},
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hidden": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func accessPackageDataRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackagesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	var accessPackage *graph.AccessPackage
+
+	if objectId, ok := d.Get("object_id").(string); ok && objectId != "" {
+		resp, _, err := client.Get(ctx, objectId)
+		if err != nil {
+			return fmt.Errorf("retrieving Access Package with ID %q: %+v", objectId, err)
+		}
+		accessPackage = resp
+	} else {
+		displayName := d.Get("display_name").(string)
+		catalogId := d.Get("catalog_id").(string)
+
+		accessPackages, err := client.List(ctx)
+		if err != nil {
+			return fmt.Errorf("listing Access Packages: %+v", err)
+		}
+
+		var matches []graph.AccessPackage
+		for _, v := range accessPackages {
+			if v.DisplayName == nil || !strings.EqualFold(*v.DisplayName, displayName) {
+				continue
+			}
+			if catalogId != "" && (v.CatalogId == nil || !strings.EqualFold(*v.CatalogId, catalogId)) {
+				continue
+			}
+			matches = append(matches, v)
+		}
+
+		if len(matches) == 0 {
+			return fmt.Errorf("found no Access Package with display name %q", displayName)
+		}
+		if len(matches) > 1 {
+			return fmt.Errorf("found multiple Access Packages with display name %q - specify `catalog_id` to disambiguate", displayName)
+		}
+
+		accessPackage = &matches[0]
+	}
+
+	if accessPackage.ID == nil {
+		return fmt.Errorf("Access Package returned with nil ID")
+	}
+
+	d.SetId(*accessPackage.ID)
+	d.Set("object_id", accessPackage.ID)
+	d.Set("display_name", accessPackage.DisplayName)
+	d.Set("catalog_id", accessPackage.CatalogId)
+	d.Set("description", accessPackage.Description)
+
+	hidden := false
+	if accessPackage.IsHidden != nil {
+		hidden = *accessPackage.IsHidden
+	}
+	d.Set("hidden", hidden)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccAccessPackageDataSource_byObjectId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageDataSource_byObjectId(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestAP-%d", data.RandomInteger)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessPackageDataSource_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageDataSource_byDisplayName(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestAP-%d", data.RandomInteger)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessPackageDataSource_byObjectId(id int) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuread_access_package" "test" {
+  object_id = azuread_access_package.test.id
+}
+`, testAccAccessPackage_basic(id))
+}
+
+func testAccAccessPackageDataSource_byDisplayName(id int) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuread_access_package" "test" {
+  display_name = azuread_access_package.test.display_name
+  catalog_id   = azuread_access_package_catalog.test.id
+}
+`, testAccAccessPackage_basic(id))
+}
@@ -0,0 +1,137 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageResource() *schema.Resource {
+	return &schema.Resource{
+		Create: accessPackageResourceCreate,
+		Read:   accessPackageResourceRead,
+		Update: accessPackageResourceUpdate,
+		Delete: accessPackageResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func accessPackageResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackagesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	properties := graph.AccessPackage{
+		CatalogId:   utils.String(d.Get("catalog_id").(string)),
+		DisplayName: utils.String(displayName),
+		Description: utils.String(d.Get("description").(string)),
+		IsHidden:    utils.Bool(d.Get("hidden").(bool)),
+	}
+
+	accessPackage, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Access Package %q: %+v", displayName, err)
+	}
+	if accessPackage.ID == nil || *accessPackage.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Access Package %q", displayName)
+	}
+
+	d.SetId(*accessPackage.ID)
+
+	return accessPackageResourceRead(d, meta)
+}
+
+func accessPackageResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackagesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AccessPackage{
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		Description: utils.String(d.Get("description").(string)),
+		IsHidden:    utils.Bool(d.Get("hidden").(bool)),
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Access Package with ID %q: %+v", d.Id(), err)
+	}
+
+	return accessPackageResourceRead(d, meta)
+}
+
+func accessPackageResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackagesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	accessPackage, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access Package with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Access Package with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("catalog_id", accessPackage.CatalogId)
+	d.Set("display_name", accessPackage.DisplayName)
+	d.Set("description", accessPackage.Description)
+	d.Set("hidden", accessPackage.IsHidden)
+
+	return nil
+}
+
+func accessPackageResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackagesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Access Package with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageResourceCatalogAssociationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: accessPackageResourceCatalogAssociationResourceCreate,
+		Read:   accessPackageResourceCatalogAssociationResourceRead,
+		Delete: accessPackageResourceCatalogAssociationResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseAccessPackageResourceCatalogAssociationId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"resource_origin_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_origin_system": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AadApplication",
+					"AadGroup",
+					"SharePointOnline",
+				}, false),
+			},
+		},
+	}
+}
+
+func accessPackageResourceCatalogAssociationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageResourceRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	catalogId := d.Get("catalog_id").(string)
+	originId := d.Get("resource_origin_id").(string)
+
+	properties := graph.AccessPackageResourceRequest{
+		CatalogId:   utils.String(catalogId),
+		RequestType: utils.String("AdminAdd"),
+		AccessPackageResource: &graph.AccessPackageResource{
+			OriginId:     utils.String(originId),
+			OriginSystem: utils.String(d.Get("resource_origin_system").(string)),
+		},
+	}
+
+	if _, _, err := client.Create(ctx, properties); err != nil {
+		return fmt.Errorf("adding resource %q to Access Package Catalog with ID %q: %+v", originId, catalogId, err)
+	}
+
+	d.SetId(graph.AccessPackageResourceCatalogAssociationIdFrom(catalogId, originId).String())
+
+	return accessPackageResourceCatalogAssociationResourceRead(d, meta)
+}
+
+func accessPackageResourceCatalogAssociationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseAccessPackageResourceCatalogAssociationId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	resource, status, err := client.GetResource(ctx, id.CatalogId, id.OriginId)
+	if err != nil {
+		return fmt.Errorf("retrieving resource %q in Access Package Catalog with ID %q: %+v", id.OriginId, id.CatalogId, err)
+	}
+	if status == http.StatusNotFound {
+		log.Printf("[DEBUG] Resource %q was not found in Access Package Catalog with ID %q - removing from state", id.OriginId, id.CatalogId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("catalog_id", id.CatalogId)
+	d.Set("resource_origin_id", resource.OriginId)
+	d.Set("resource_origin_system", resource.OriginSystem)
+
+	return nil
+}
+
+func accessPackageResourceCatalogAssociationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackageResourceRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseAccessPackageResourceCatalogAssociationId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	properties := graph.AccessPackageResourceRequest{
+		CatalogId:   utils.String(id.CatalogId),
+		RequestType: utils.String("AdminRemove"),
+		AccessPackageResource: &graph.AccessPackageResource{
+			OriginId:     utils.String(id.OriginId),
+			OriginSystem: utils.String(d.Get("resource_origin_system").(string)),
+		},
+	}
+
+	if _, _, err := client.Create(ctx, properties); err != nil {
+		return fmt.Errorf("removing resource %q from Access Package Catalog with ID %q: %+v", id.OriginId, id.CatalogId, err)
+	}
+
+	return nil
+}
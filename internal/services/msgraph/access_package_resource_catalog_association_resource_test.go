@@ -0,0 +1,109 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccAccessPackageResourceCatalogAssociation_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_resource_catalog_association", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageResourceCatalogAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageResourceCatalogAssociation_group(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageResourceCatalogAssociationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "resource_origin_system", "AadGroup"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAccessPackageResourceCatalogAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseAccessPackageResourceCatalogAssociationId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		if _, status, err := client.GetResource(ctx, id.CatalogId, id.OriginId); err != nil {
+			return fmt.Errorf("retrieving resource %q in Access Package Catalog %q: %+v", id.OriginId, id.CatalogId, err)
+		} else if status == http.StatusNotFound {
+			return fmt.Errorf("resource %q does not exist in Access Package Catalog %q", id.OriginId, id.CatalogId)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAccessPackageResourceCatalogAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_access_package_resource_catalog_association" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseAccessPackageResourceCatalogAssociationId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		res, status, err := client.GetResource(ctx, id.CatalogId, id.OriginId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			continue // the catalog was destroyed along with the association
+		}
+		if status == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("Access Package Catalog resource association still exists:\n%#v", res)
+	}
+
+	return nil
+}
+
+func testAccAccessPackageResourceCatalogAssociation_group(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%[1]d"
+}
+
+resource "azuread_access_package_resource_catalog_association" "test" {
+  catalog_id             = azuread_access_package_catalog.test.id
+  resource_origin_id     = azuread_group.test.id
+  resource_origin_system = "AadGroup"
+}
+`, id)
+}
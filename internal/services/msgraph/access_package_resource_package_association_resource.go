@@ -0,0 +1,177 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessPackageResourcePackageAssociationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: accessPackageResourcePackageAssociationResourceCreate,
+		Read:   accessPackageResourcePackageAssociationResourceRead,
+		Delete: accessPackageResourcePackageAssociationResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseAccessPackageResourceRoleScopeId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"access_package_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"catalog_resource_association_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(val interface{}, key string) ([]string, []error) {
+					if _, err := graph.ParseAccessPackageResourceCatalogAssociationId(val.(string)); err != nil {
+						return nil, []error{err}
+					}
+					return nil, nil
+				},
+			},
+
+			"access_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Member",
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func accessPackageResourcePackageAssociationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackagesClient
+	catalogsClient := meta.(*clients.AadClient).MsGraph.AccessPackageCatalogsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	accessPackageId := d.Get("access_package_id").(string)
+	accessType := d.Get("access_type").(string)
+
+	catalogAssociationId, err := graph.ParseAccessPackageResourceCatalogAssociationId(d.Get("catalog_resource_association_id").(string))
+	if err != nil {
+		return fmt.Errorf("unable to parse catalog association ID: %v", err)
+	}
+
+	resource, status, err := catalogsClient.GetResource(ctx, catalogAssociationId.CatalogId, catalogAssociationId.OriginId)
+	if err != nil {
+		return fmt.Errorf("retrieving resource %q in Access Package Catalog with ID %q: %+v", catalogAssociationId.OriginId, catalogAssociationId.CatalogId, err)
+	}
+	if status == http.StatusNotFound {
+		return fmt.Errorf("resource %q was not found in Access Package Catalog with ID %q", catalogAssociationId.OriginId, catalogAssociationId.CatalogId)
+	}
+
+	properties := graph.AccessPackageResourceRoleScope{
+		AccessPackageResourceRole: &graph.AccessPackageResourceRole{
+			DisplayName:  utils.String(accessType),
+			OriginId:     utils.String(fmt.Sprintf("%s_%s", accessType, catalogAssociationId.OriginId)),
+			OriginSystem: resource.OriginSystem,
+			AccessPackageResource: &graph.AccessPackageResource{
+				ID:           resource.ID,
+				OriginId:     resource.OriginId,
+				ResourceType: resource.ResourceType,
+			},
+		},
+		AccessPackageResourceScope: &graph.AccessPackageResourceScope{
+			OriginId:     utils.String(catalogAssociationId.OriginId),
+			OriginSystem: resource.OriginSystem,
+		},
+	}
+
+	roleScope, _, err := client.CreateResourceRoleScope(ctx, accessPackageId, properties)
+	if err != nil {
+		return fmt.Errorf("associating resource %q with Access Package with ID %q: %+v", catalogAssociationId.OriginId, accessPackageId, err)
+	}
+	if roleScope.ID == nil || *roleScope.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for role scope on Access Package with ID %q", accessPackageId)
+	}
+
+	d.SetId(graph.AccessPackageResourceRoleScopeIdFrom(accessPackageId, *roleScope.ID).String())
+
+	return accessPackageResourcePackageAssociationResourceRead(d, meta)
+}
+
+func accessPackageResourcePackageAssociationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackagesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseAccessPackageResourceRoleScopeId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	roleScopes, err := client.ListResourceRoleScopes(ctx, id.AccessPackageId)
+	if err != nil {
+		return fmt.Errorf("listing role scopes for Access Package with ID %q: %+v", id.AccessPackageId, err)
+	}
+
+	var roleScope *graph.AccessPackageResourceRoleScope
+	for _, rs := range roleScopes {
+		if rs.ID != nil && *rs.ID == id.RoleScopeId {
+			roleScope = &rs
+			break
+		}
+	}
+
+	if roleScope == nil {
+		log.Printf("[DEBUG] Role scope %q was not found on Access Package with ID %q - removing from state", id.RoleScopeId, id.AccessPackageId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("access_package_id", id.AccessPackageId)
+
+	if role := roleScope.AccessPackageResourceRole; role != nil && role.DisplayName != nil {
+		d.Set("access_type", role.DisplayName)
+	}
+
+	// the catalog is not returned with the role scope, so look it up via the access package
+	if scope := roleScope.AccessPackageResourceScope; scope != nil && scope.OriginId != nil {
+		accessPackage, _, err := client.Get(ctx, id.AccessPackageId)
+		if err != nil {
+			return fmt.Errorf("retrieving Access Package with ID %q: %+v", id.AccessPackageId, err)
+		}
+
+		if accessPackage.CatalogId != nil {
+			catalogAssociationId := graph.AccessPackageResourceCatalogAssociationIdFrom(*accessPackage.CatalogId, *scope.OriginId)
+			d.Set("catalog_resource_association_id", catalogAssociationId.String())
+		}
+	}
+
+	return nil
+}
+
+func accessPackageResourcePackageAssociationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessPackagesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseAccessPackageResourceRoleScopeId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	if status, err := client.DeleteResourceRoleScope(ctx, id.AccessPackageId, id.RoleScopeId); err != nil {
+		if status != http.StatusNotFound && !strings.Contains(err.Error(), "NotFound") {
+			return fmt.Errorf("removing role scope %q from Access Package with ID %q: %+v", id.RoleScopeId, id.AccessPackageId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccAccessPackageResourcePackageAssociation_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_resource_package_association", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageResourcePackageAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackageResourcePackageAssociation_group(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageResourcePackageAssociationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "access_type", "Member"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAccessPackageResourcePackageAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackagesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseAccessPackageResourceRoleScopeId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		roleScopes, err := client.ListResourceRoleScopes(ctx, id.AccessPackageId)
+		if err != nil {
+			return fmt.Errorf("listing role scopes for Access Package %q: %+v", id.AccessPackageId, err)
+		}
+
+		for _, roleScope := range roleScopes {
+			if roleScope.ID != nil && *roleScope.ID == id.RoleScopeId {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("role scope %q does not exist on Access Package %q", id.RoleScopeId, id.AccessPackageId)
+	}
+}
+
+func testCheckAccessPackageResourcePackageAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_access_package_resource_package_association" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackagesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseAccessPackageResourceRoleScopeId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		roleScopes, err := client.ListResourceRoleScopes(ctx, id.AccessPackageId)
+		if err != nil {
+			continue // the access package was destroyed along with the association
+		}
+
+		for _, roleScope := range roleScopes {
+			if roleScope.ID != nil && *roleScope.ID == id.RoleScopeId {
+				return fmt.Errorf("Access Package resource association still exists:\n%#v", roleScope)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAccessPackageResourcePackageAssociation_group(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctestAP-%[1]d"
+}
+
+resource "azuread_access_package_resource_catalog_association" "test" {
+  catalog_id             = azuread_access_package_catalog.test.id
+  resource_origin_id     = azuread_group.test.id
+  resource_origin_system = "AadGroup"
+}
+
+resource "azuread_access_package_resource_package_association" "test" {
+  access_package_id               = azuread_access_package.test.id
+  catalog_resource_association_id = azuread_access_package_resource_catalog_association.test.id
+}
+`, id)
+}
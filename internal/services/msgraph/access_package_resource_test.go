@@ -0,0 +1,134 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAccessPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackage_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestAP-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "hidden", "false"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAccessPackage_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPackage_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageExists(data.ResourceName),
+				),
+			},
+			{
+				Config: testAccAccessPackage_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessPackageExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "description", "Acceptance test package"),
+					resource.TestCheckResourceAttr(data.ResourceName, "hidden", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAccessPackageExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackagesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Access Package %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Access Package %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAccessPackageDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_access_package" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessPackagesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		accessPackage, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Access Package still exists:\n%#v", accessPackage)
+	}
+
+	return nil
+}
+
+func testAccAccessPackage_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctestAP-%[1]d"
+}
+`, id)
+}
+
+func testAccAccessPackage_complete(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctestAPC-%[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctestAP-%[1]d"
+  description  = "Acceptance test package"
+  hidden       = true
+}
+`, id)
+}
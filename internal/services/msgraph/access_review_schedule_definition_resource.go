@@ -0,0 +1,316 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func accessReviewScheduleDefinitionResource() *schema.Resource {
+	return &schema.Resource{
+		Create: accessReviewScheduleDefinitionResourceCreate,
+		Read:   accessReviewScheduleDefinitionResourceRead,
+		Update: accessReviewScheduleDefinitionResourceUpdate,
+		Delete: accessReviewScheduleDefinitionResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description_for_admins": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description_for_reviewers": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// e.g. /groups/{id}/transitiveMembers to review the members of a group
+			"scope_query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"reviewer": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"duration_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 180),
+			},
+
+			"auto_apply_decisions_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"default_decision": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "None",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Approve",
+					"Deny",
+					"None",
+					"Recommendation",
+				}, false),
+			},
+
+			"justification_required_on_approval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"recommendations_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"recurrence_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"absoluteMonthly",
+					"weekly",
+				}, false),
+			},
+
+			"recurrence_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 12),
+			},
+
+			"start_date": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"end_date": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func accessReviewScheduleDefinitionResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessReviewScheduleDefinitionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	definition, _, err := client.Create(ctx, expandAccessReviewScheduleDefinition(d))
+	if err != nil {
+		return fmt.Errorf("creating Access Review Schedule Definition %q: %+v", displayName, err)
+	}
+	if definition.ID == nil || *definition.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Access Review Schedule Definition %q", displayName)
+	}
+
+	d.SetId(*definition.ID)
+
+	return accessReviewScheduleDefinitionResourceRead(d, meta)
+}
+
+func accessReviewScheduleDefinitionResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessReviewScheduleDefinitionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	definition := expandAccessReviewScheduleDefinition(d)
+	definition.ID = utils.String(d.Id())
+
+	if _, err := client.Update(ctx, d.Id(), definition); err != nil {
+		return fmt.Errorf("updating Access Review Schedule Definition with ID %q: %+v", d.Id(), err)
+	}
+
+	return accessReviewScheduleDefinitionResourceRead(d, meta)
+}
+
+func accessReviewScheduleDefinitionResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessReviewScheduleDefinitionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	definition, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access Review Schedule Definition with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Access Review Schedule Definition with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("display_name", definition.DisplayName)
+	d.Set("description_for_admins", definition.DescriptionForAdmins)
+	d.Set("description_for_reviewers", definition.DescriptionForReviewers)
+	d.Set("status", definition.Status)
+
+	if definition.Scope != nil {
+		d.Set("scope_query", definition.Scope.Query)
+	}
+
+	reviewers := make([]map[string]interface{}, 0)
+	if definition.Reviewers != nil {
+		for _, reviewer := range *definition.Reviewers {
+			query := ""
+			if reviewer.Query != nil {
+				query = *reviewer.Query
+			}
+
+			reviewers = append(reviewers, map[string]interface{}{
+				"query": query,
+			})
+		}
+	}
+	d.Set("reviewer", reviewers)
+
+	if settings := definition.Settings; settings != nil {
+		if settings.InstanceDurationInDays != nil {
+			d.Set("duration_in_days", int(*settings.InstanceDurationInDays))
+		}
+		d.Set("auto_apply_decisions_enabled", settings.AutoApplyDecisionsEnabled)
+		d.Set("default_decision", settings.DefaultDecision)
+		d.Set("justification_required_on_approval", settings.JustificationRequiredOnApproval)
+		d.Set("recommendations_enabled", settings.RecommendationsEnabled)
+
+		if recurrence := settings.Recurrence; recurrence != nil {
+			if recurrence.Pattern != nil {
+				d.Set("recurrence_type", recurrence.Pattern.Type)
+				if recurrence.Pattern.Interval != nil {
+					d.Set("recurrence_interval", int(*recurrence.Pattern.Interval))
+				}
+			}
+
+			if recurrence.Range != nil {
+				d.Set("start_date", recurrence.Range.StartDate)
+				d.Set("end_date", recurrence.Range.EndDate)
+			}
+		}
+	}
+
+	return nil
+}
+
+func accessReviewScheduleDefinitionResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AccessReviewScheduleDefinitionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Access Review Schedule Definition with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandAccessReviewScheduleDefinition(d *schema.ResourceData) graph.AccessReviewScheduleDefinition {
+	reviewers := make([]graph.AccessReviewScope, 0)
+	for _, raw := range d.Get("reviewer").([]interface{}) {
+		reviewer := raw.(map[string]interface{})
+		reviewers = append(reviewers, graph.AccessReviewScope{
+			Query:     utils.String(reviewer["query"].(string)),
+			QueryType: utils.String("MicrosoftGraph"),
+		})
+	}
+
+	settings := graph.AccessReviewScheduleSettings{
+		InstanceDurationInDays:          utils.Int32(int32(d.Get("duration_in_days").(int))),
+		AutoApplyDecisionsEnabled:       utils.Bool(d.Get("auto_apply_decisions_enabled").(bool)),
+		DefaultDecision:                 utils.String(d.Get("default_decision").(string)),
+		DefaultDecisionEnabled:          utils.Bool(d.Get("default_decision").(string) != "None"),
+		JustificationRequiredOnApproval: utils.Bool(d.Get("justification_required_on_approval").(bool)),
+		MailNotificationsEnabled:        utils.Bool(true),
+		RecommendationsEnabled:          utils.Bool(d.Get("recommendations_enabled").(bool)),
+		ReminderNotificationsEnabled:    utils.Bool(true),
+	}
+
+	if recurrenceType, ok := d.GetOk("recurrence_type"); ok {
+		recurrenceRange := graph.AccessReviewRecurrenceRange{
+			Type: utils.String("noEnd"),
+		}
+
+		if v, ok := d.GetOk("start_date"); ok {
+			recurrenceRange.StartDate = utils.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("end_date"); ok {
+			recurrenceRange.Type = utils.String("endDate")
+			recurrenceRange.EndDate = utils.String(v.(string))
+		}
+
+		settings.Recurrence = &graph.AccessReviewRecurrence{
+			Pattern: &graph.AccessReviewRecurrencePattern{
+				Type:     utils.String(recurrenceType.(string)),
+				Interval: utils.Int32(int32(d.Get("recurrence_interval").(int))),
+			},
+			Range: &recurrenceRange,
+		}
+	}
+
+	definition := graph.AccessReviewScheduleDefinition{
+		DisplayName:             utils.String(d.Get("display_name").(string)),
+		DescriptionForAdmins:    utils.String(d.Get("description_for_admins").(string)),
+		DescriptionForReviewers: utils.String(d.Get("description_for_reviewers").(string)),
+		Scope: &graph.AccessReviewScope{
+			Query:     utils.String(d.Get("scope_query").(string)),
+			QueryType: utils.String("MicrosoftGraph"),
+		},
+		Settings: &settings,
+	}
+
+	if len(reviewers) > 0 {
+		definition.Reviewers = &reviewers
+	}
+
+	return definition
+}
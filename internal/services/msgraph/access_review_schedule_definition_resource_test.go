@@ -0,0 +1,112 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAccessReviewScheduleDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_review_schedule_definition", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAccessReviewScheduleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessReviewScheduleDefinition_basic(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAccessReviewScheduleDefinitionExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestARSD-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "recurrence_type", "absoluteMonthly"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAccessReviewScheduleDefinitionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessReviewScheduleDefinitionsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Access Review Schedule Definition %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Access Review Schedule Definition %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAccessReviewScheduleDefinitionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_access_review_schedule_definition" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AccessReviewScheduleDefinitionsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		definition, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Access Review Schedule Definition still exists:\n%#v", definition)
+	}
+
+	return nil
+}
+
+func testAccAccessReviewScheduleDefinition_basic(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "reviewer" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_access_review_schedule_definition" "test" {
+  display_name           = "acctestARSD-%[1]d"
+  description_for_admins = "Acceptance test review"
+  scope_query            = "/groups/${azuread_group.test.id}/transitiveMembers"
+  duration_in_days       = 3
+  recurrence_type        = "absoluteMonthly"
+  recurrence_interval    = 3
+
+  reviewer {
+    query = "/users/${azuread_user.reviewer.id}"
+  }
+}
+`, id, password)
+}
@@ -0,0 +1,202 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+// adminConsentRequestPolicyId is the fixed ID of the tenant-wide admin consent
+// request policy singleton.
+const adminConsentRequestPolicyId = "adminConsentRequestPolicy"
+
+func adminConsentRequestPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: adminConsentRequestPolicyResourceCreateUpdate,
+		Read:   adminConsentRequestPolicyResourceRead,
+		Update: adminConsentRequestPolicyResourceCreateUpdate,
+		Delete: adminConsentRequestPolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != adminConsentRequestPolicyId {
+				return fmt.Errorf("specified ID (%q) must be %q", id, adminConsentRequestPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"notify_reviewers": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"reminders_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"request_duration_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(0, 30),
+			},
+
+			"reviewer": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"query_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "MicrosoftGraph",
+						},
+
+						"query_root": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func adminConsentRequestPolicyResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdminConsentRequestPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AdminConsentRequestPolicy{
+		IsEnabled:             utils.Bool(d.Get("enabled").(bool)),
+		NotifyReviewers:       utils.Bool(d.Get("notify_reviewers").(bool)),
+		RemindersEnabled:      utils.Bool(d.Get("reminders_enabled").(bool)),
+		RequestDurationInDays: utils.Int32(int32(d.Get("request_duration_in_days").(int))),
+		Reviewers:             expandAdminConsentReviewers(d.Get("reviewer").([]interface{})),
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return fmt.Errorf("updating Admin Consent Request Policy: %+v", err)
+	}
+
+	d.SetId(adminConsentRequestPolicyId)
+
+	return adminConsentRequestPolicyResourceRead(d, meta)
+}
+
+func adminConsentRequestPolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdminConsentRequestPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, _, err := client.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving Admin Consent Request Policy: %+v", err)
+	}
+
+	d.Set("enabled", policy.IsEnabled)
+	d.Set("notify_reviewers", policy.NotifyReviewers)
+	d.Set("reminders_enabled", policy.RemindersEnabled)
+	d.Set("version", policy.Version)
+
+	requestDuration := 0
+	if policy.RequestDurationInDays != nil {
+		requestDuration = int(*policy.RequestDurationInDays)
+	}
+	d.Set("request_duration_in_days", requestDuration)
+
+	reviewers := make([]interface{}, 0)
+	if policy.Reviewers != nil {
+		for _, reviewer := range *policy.Reviewers {
+			query := ""
+			if reviewer.Query != nil {
+				query = *reviewer.Query
+			}
+
+			queryType := ""
+			if reviewer.QueryType != nil {
+				queryType = *reviewer.QueryType
+			}
+
+			queryRoot := ""
+			if reviewer.QueryRoot != nil {
+				queryRoot = *reviewer.QueryRoot
+			}
+
+			reviewers = append(reviewers, map[string]interface{}{
+				"query":      query,
+				"query_type": queryType,
+				"query_root": queryRoot,
+			})
+		}
+	}
+	d.Set("reviewer", reviewers)
+
+	return nil
+}
+
+// adminConsentRequestPolicyResourceDelete disables the admin consent workflow and clears
+// the reviewer list, since the underlying policy is a tenant singleton which cannot
+// be deleted.
+func adminConsentRequestPolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdminConsentRequestPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AdminConsentRequestPolicy{
+		IsEnabled:             utils.Bool(false),
+		NotifyReviewers:       utils.Bool(false),
+		RemindersEnabled:      utils.Bool(false),
+		RequestDurationInDays: utils.Int32(30),
+		Reviewers:             &[]graph.AccessReviewReviewerScope{},
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return fmt.Errorf("resetting Admin Consent Request Policy: %+v", err)
+	}
+
+	return nil
+}
+
+func expandAdminConsentReviewers(in []interface{}) *[]graph.AccessReviewReviewerScope {
+	reviewers := make([]graph.AccessReviewReviewerScope, 0)
+	for _, raw := range in {
+		reviewer := raw.(map[string]interface{})
+
+		scope := graph.AccessReviewReviewerScope{
+			Query:     utils.String(reviewer["query"].(string)),
+			QueryType: utils.String(reviewer["query_type"].(string)),
+		}
+
+		if v := reviewer["query_root"].(string); v != "" {
+			scope.QueryRoot = utils.String(v)
+		}
+
+		reviewers = append(reviewers, scope)
+	}
+	return &reviewers
+}
@@ -0,0 +1,86 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAdminConsentRequestPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_admin_consent_request_policy", "test")
+
+	// not Parallel, as the admin consent request policy is a tenant singleton
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAdminConsentRequestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminConsentRequestPolicy_basic(data.RandomInteger, data.RandomPassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAdminConsentRequestPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(data.ResourceName, "reviewer.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAdminConsentRequestPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[name]; !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AdminConsentRequestPolicyClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		policy, _, err := client.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("retrieving Admin Consent Request Policy: %+v", err)
+		}
+
+		if policy.IsEnabled == nil || !*policy.IsEnabled {
+			return fmt.Errorf("Admin Consent Request Policy is not enabled")
+		}
+
+		return nil
+	}
+}
+
+func testCheckAdminConsentRequestPolicyDestroy(s *terraform.State) error {
+	// the admin consent request policy cannot be deleted, it is disabled on destroy,
+	// so there is nothing to verify here
+	return nil
+}
+
+func testAccAdminConsentRequestPolicy_basic(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestReviewer.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestReviewer-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_admin_consent_request_policy" "test" {
+  enabled                  = true
+  notify_reviewers         = true
+  request_duration_in_days = 14
+
+  reviewer {
+    query = "/users/${azuread_user.test.object_id}"
+  }
+}
+`, id, password)
+}
@@ -0,0 +1,126 @@
+package msgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+const administrativeUnitMemberResourceName = "azuread_administrative_unit_member"
+
+func administrativeUnitMemberResource() *schema.Resource {
+	return &schema.Resource{
+		Create: administrativeUnitMemberResourceCreate,
+		Read:   administrativeUnitMemberResourceRead,
+		Delete: administrativeUnitMemberResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseAdministrativeUnitMemberId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"administrative_unit_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"member_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func administrativeUnitMemberResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	administrativeUnitID := d.Get("administrative_unit_object_id").(string)
+	memberID := d.Get("member_object_id").(string)
+
+	id := graph.AdministrativeUnitMemberIdFrom(administrativeUnitID, memberID)
+
+	tf.LockByName(administrativeUnitMemberResourceName, administrativeUnitID)
+	defer tf.UnlockByName(administrativeUnitMemberResourceName, administrativeUnitID)
+
+	existingMembers, err := client.ListMembers(ctx, administrativeUnitID)
+	if err != nil {
+		return fmt.Errorf("listing existing members for Administrative Unit with ID %q: %+v", administrativeUnitID, err)
+	}
+	for _, v := range existingMembers {
+		if strings.EqualFold(v, memberID) {
+			return tf.ImportAsExistsError(administrativeUnitMemberResourceName, id.String())
+		}
+	}
+
+	if err := client.AddMember(ctx, administrativeUnitID, memberID); err != nil {
+		return err
+	}
+
+	d.SetId(id.String())
+	return administrativeUnitMemberResourceRead(d, meta)
+}
+
+func administrativeUnitMemberResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseAdministrativeUnitMemberId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	members, err := client.ListMembers(ctx, id.AdministrativeUnitId)
+	if err != nil {
+		return fmt.Errorf("listing members for Administrative Unit with ID %q: %+v", id.AdministrativeUnitId, err)
+	}
+
+	var memberObjectID string
+	for _, objectID := range members {
+		if strings.EqualFold(objectID, id.MemberId) {
+			memberObjectID = objectID
+			break
+		}
+	}
+
+	if memberObjectID == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("administrative_unit_object_id", id.AdministrativeUnitId)
+	d.Set("member_object_id", memberObjectID)
+
+	return nil
+}
+
+func administrativeUnitMemberResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseAdministrativeUnitMemberId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	tf.LockByName(administrativeUnitMemberResourceName, id.AdministrativeUnitId)
+	defer tf.UnlockByName(administrativeUnitMemberResourceName, id.AdministrativeUnitId)
+
+	if err := client.RemoveMember(ctx, id.AdministrativeUnitId, id.MemberId); err != nil {
+		return err
+	}
+
+	return nil
+}
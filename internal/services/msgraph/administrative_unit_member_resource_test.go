@@ -0,0 +1,124 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccAdministrativeUnitMember_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit_member", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAdministrativeUnitMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdministrativeUnitMember_user(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "administrative_unit_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "member_object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAdministrativeUnitMember_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit_member", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAdministrativeUnitMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdministrativeUnitMember_group(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "administrative_unit_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "member_object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAdministrativeUnitMemberDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_administrative_unit_member" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseAdministrativeUnitMemberId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		members, err := client.ListMembers(ctx, id.AdministrativeUnitId)
+		if err != nil {
+			continue // the administrative unit was destroyed along with the membership
+		}
+
+		for _, objectID := range members {
+			if objectID == id.MemberId {
+				return fmt.Errorf("Administrative Unit membership still exists:\n%#v", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAdministrativeUnitMember_user(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_administrative_unit" "test" {
+  display_name = "acctestAdministrativeUnit-%[1]d"
+}
+
+resource "azuread_administrative_unit_member" "test" {
+  administrative_unit_object_id = azuread_administrative_unit.test.id
+  member_object_id              = azuread_user.test.id
+}
+`, id, password)
+}
+
+func testAccAdministrativeUnitMember_group(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "member" {
+  name = "acctestGroup-%[1]d-Member"
+}
+
+resource "azuread_administrative_unit" "test" {
+  display_name = "acctestAdministrativeUnit-%[1]d"
+}
+
+resource "azuread_administrative_unit_member" "test" {
+  administrative_unit_object_id = azuread_administrative_unit.test.id
+  member_object_id              = azuread_group.member.id
+}
+`, id)
+}
@@ -0,0 +1,144 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func administrativeUnitResource() *schema.Resource {
+	return &schema.Resource{
+		Create: administrativeUnitResourceCreate,
+		Read:   administrativeUnitResourceRead,
+		Update: administrativeUnitResourceUpdate,
+		Delete: administrativeUnitResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hidden_membership_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func administrativeUnitResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	visibility := graph.AdministrativeUnitVisibilityPublic
+	if d.Get("hidden_membership_enabled").(bool) {
+		visibility = graph.AdministrativeUnitVisibilityHiddenMembership
+	}
+
+	properties := graph.AdministrativeUnit{
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		Description: utils.String(d.Get("description").(string)),
+		Visibility:  utils.String(visibility),
+	}
+
+	administrativeUnit, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Administrative Unit %q: %+v", d.Get("display_name").(string), err)
+	}
+
+	if administrativeUnit.ID == nil || *administrativeUnit.ID == "" {
+		return fmt.Errorf("Administrative Unit ID is empty or nil")
+	}
+
+	d.SetId(*administrativeUnit.ID)
+
+	return administrativeUnitResourceRead(d, meta)
+}
+
+func administrativeUnitResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	var properties graph.AdministrativeUnit
+
+	if d.HasChange("display_name") {
+		properties.DisplayName = utils.String(d.Get("display_name").(string))
+	}
+
+	if d.HasChange("description") {
+		properties.Description = utils.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("hidden_membership_enabled") {
+		visibility := graph.AdministrativeUnitVisibilityPublic
+		if d.Get("hidden_membership_enabled").(bool) {
+			visibility = graph.AdministrativeUnitVisibilityHiddenMembership
+		}
+		properties.Visibility = utils.String(visibility)
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Administrative Unit with ID %q: %+v", d.Id(), err)
+	}
+
+	return administrativeUnitResourceRead(d, meta)
+}
+
+func administrativeUnitResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	administrativeUnit, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Administrative Unit with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Administrative Unit with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("display_name", administrativeUnit.DisplayName)
+	d.Set("description", administrativeUnit.Description)
+
+	hiddenMembership := administrativeUnit.Visibility != nil && *administrativeUnit.Visibility == graph.AdministrativeUnitVisibilityHiddenMembership
+	d.Set("hidden_membership_enabled", hiddenMembership)
+
+	return nil
+}
+
+func administrativeUnitResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if _, err := client.Delete(ctx, d.Id()); err != nil {
+		return fmt.Errorf("deleting Administrative Unit with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
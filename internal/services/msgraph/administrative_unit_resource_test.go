@@ -0,0 +1,144 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAdministrativeUnit_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAdministrativeUnitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdministrativeUnit_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAdministrativeUnitExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestAdministrativeUnit-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "hidden_membership_enabled", "false"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAdministrativeUnit_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAdministrativeUnitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdministrativeUnit_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAdministrativeUnitExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "description", "testing administrative units"),
+					resource.TestCheckResourceAttr(data.ResourceName, "hidden_membership_enabled", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAdministrativeUnit_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAdministrativeUnitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdministrativeUnit_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAdministrativeUnitExists(data.ResourceName),
+				),
+			},
+			{
+				Config: testAccAdministrativeUnit_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAdministrativeUnitExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "hidden_membership_enabled", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAdministrativeUnitExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Administrative Unit %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Administrative Unit %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAdministrativeUnitDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_administrative_unit" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		administrativeUnit, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Administrative Unit still exists:\n%#v", administrativeUnit)
+	}
+
+	return nil
+}
+
+func testAccAdministrativeUnit_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_administrative_unit" "test" {
+  display_name = "acctestAdministrativeUnit-%d"
+}
+`, id)
+}
+
+func testAccAdministrativeUnit_complete(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_administrative_unit" "test" {
+  display_name              = "acctestAdministrativeUnit-%d"
+  description               = "testing administrative units"
+  hidden_membership_enabled = true
+}
+`, id)
+}
@@ -0,0 +1,125 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func administrativeUnitRoleMemberResource() *schema.Resource {
+	return &schema.Resource{
+		Create: administrativeUnitRoleMemberResourceCreate,
+		Read:   administrativeUnitRoleMemberResourceRead,
+		Delete: administrativeUnitRoleMemberResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseScopedRoleMembershipId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"administrative_unit_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"role_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"member_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func administrativeUnitRoleMemberResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	administrativeUnitID := d.Get("administrative_unit_object_id").(string)
+
+	properties := graph.ScopedRoleMembership{
+		RoleId: utils.String(d.Get("role_object_id").(string)),
+		RoleMemberInfo: &graph.ScopedRoleMemberInfo{
+			ID: utils.String(d.Get("member_object_id").(string)),
+		},
+	}
+
+	membership, _, err := client.AddScopedRoleMember(ctx, administrativeUnitID, properties)
+	if err != nil {
+		return fmt.Errorf("adding scoped role member to Administrative Unit with ID %q: %+v", administrativeUnitID, err)
+	}
+
+	if membership.ID == nil || *membership.ID == "" {
+		return fmt.Errorf("Scoped Role Membership ID is empty or nil")
+	}
+
+	id := graph.ScopedRoleMembershipIdFrom(administrativeUnitID, *membership.ID)
+	d.SetId(id.String())
+
+	return administrativeUnitRoleMemberResourceRead(d, meta)
+}
+
+func administrativeUnitRoleMemberResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseScopedRoleMembershipId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	membership, status, err := client.GetScopedRoleMember(ctx, id.AdministrativeUnitId, id.ScopedRoleMemberId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Scoped Role Membership %q was not found in Administrative Unit %q - removing from state", id.ScopedRoleMemberId, id.AdministrativeUnitId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Scoped Role Membership %q for Administrative Unit with ID %q: %+v", id.ScopedRoleMemberId, id.AdministrativeUnitId, err)
+	}
+
+	d.Set("administrative_unit_object_id", id.AdministrativeUnitId)
+	d.Set("role_object_id", membership.RoleId)
+
+	if membership.RoleMemberInfo != nil {
+		d.Set("member_object_id", membership.RoleMemberInfo.ID)
+	}
+
+	return nil
+}
+
+func administrativeUnitRoleMemberResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseScopedRoleMembershipId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	if _, err := client.RemoveScopedRoleMember(ctx, id.AdministrativeUnitId, id.ScopedRoleMemberId); err != nil {
+		return fmt.Errorf("removing Scoped Role Membership %q from Administrative Unit with ID %q: %+v", id.ScopedRoleMemberId, id.AdministrativeUnitId, err)
+	}
+
+	return nil
+}
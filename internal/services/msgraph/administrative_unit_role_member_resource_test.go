@@ -0,0 +1,94 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccAdministrativeUnitRoleMember_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit_role_member", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAdministrativeUnitRoleMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdministrativeUnitRoleMember_user(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "administrative_unit_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "role_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "member_object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAdministrativeUnitRoleMemberDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_administrative_unit_role_member" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AdministrativeUnitsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseScopedRoleMembershipId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		membership, status, err := client.GetScopedRoleMember(ctx, id.AdministrativeUnitId, id.ScopedRoleMemberId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Scoped Role Membership still exists:\n%#v", membership)
+	}
+
+	return nil
+}
+
+func testAccAdministrativeUnitRoleMember_user(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_administrative_unit" "test" {
+  display_name = "acctestAdministrativeUnit-%[1]d"
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Helpdesk Administrator"
+}
+
+resource "azuread_administrative_unit_role_member" "test" {
+  administrative_unit_object_id = azuread_administrative_unit.test.id
+  role_object_id                = azuread_directory_role.test.object_id
+  member_object_id              = azuread_user.test.id
+}
+`, id, password)
+}
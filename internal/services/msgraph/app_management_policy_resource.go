@@ -0,0 +1,305 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func appManagementPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: appManagementPolicyResourceCreate,
+		Read:   appManagementPolicyResourceRead,
+		Update: appManagementPolicyResourceUpdate,
+		Delete: appManagementPolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     appManagementRestrictionsSchema(),
+			},
+		},
+	}
+}
+
+// appManagementRestrictionsSchema describes the credential restrictions for an app
+// management policy, and is shared with the tenant-wide default policy
+func appManagementRestrictionsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password_credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restriction_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								graph.AppManagementRestrictionTypeCustomPasswordAddition,
+								graph.AppManagementRestrictionTypePasswordAddition,
+								graph.AppManagementRestrictionTypePasswordLifetime,
+								graph.AppManagementRestrictionTypeSymmetricKeyAddition,
+								graph.AppManagementRestrictionTypeSymmetricKeyLifetime,
+							}, false),
+						},
+
+						// an ISO 8601 duration, e.g. P90D for 90 days
+						"max_lifetime": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"restrict_for_apps_created_after": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+
+			"key_credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restriction_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								graph.AppManagementRestrictionTypeAsymmetricKeyLifetime,
+							}, false),
+						},
+
+						"max_lifetime": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"restrict_for_apps_created_after": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func appManagementPolicyResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	properties := graph.AppManagementPolicy{
+		Description:  utils.String(d.Get("description").(string)),
+		DisplayName:  utils.String(displayName),
+		IsEnabled:    utils.Bool(d.Get("enabled").(bool)),
+		Restrictions: expandAppManagementRestrictions(d.Get("restrictions").([]interface{})),
+	}
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating app management policy %q: %+v", displayName, err)
+	}
+
+	if policy.ID == nil {
+		return fmt.Errorf("nil ID returned for app management policy %q", displayName)
+	}
+
+	d.SetId(*policy.ID)
+
+	return appManagementPolicyResourceRead(d, meta)
+}
+
+func appManagementPolicyResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AppManagementPolicy{}
+
+	if d.HasChange("display_name") {
+		properties.DisplayName = utils.String(d.Get("display_name").(string))
+	}
+
+	if d.HasChange("description") {
+		properties.Description = utils.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("enabled") {
+		properties.IsEnabled = utils.Bool(d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("restrictions") {
+		properties.Restrictions = expandAppManagementRestrictions(d.Get("restrictions").([]interface{}))
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating app management policy with ID %q: %+v", d.Id(), err)
+	}
+
+	return appManagementPolicyResourceRead(d, meta)
+}
+
+func appManagementPolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] App management policy with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving app management policy with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("display_name", policy.DisplayName)
+	d.Set("description", policy.Description)
+	d.Set("enabled", policy.IsEnabled)
+	d.Set("restrictions", flattenAppManagementRestrictions(policy.Restrictions))
+
+	return nil
+}
+
+func appManagementPolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting app management policy with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandAppManagementRestrictions(in []interface{}) *graph.AppManagementRestrictions {
+	// empty restriction lists are sent explicitly, so that removed restrictions are cleared
+	if len(in) == 0 || in[0] == nil {
+		return &graph.AppManagementRestrictions{
+			KeyCredentials:      &[]graph.CredentialRestriction{},
+			PasswordCredentials: &[]graph.CredentialRestriction{},
+		}
+	}
+
+	restrictions := in[0].(map[string]interface{})
+
+	return &graph.AppManagementRestrictions{
+		KeyCredentials:      expandAppManagementCredentialRestrictions(restrictions["key_credential"].([]interface{})),
+		PasswordCredentials: expandAppManagementCredentialRestrictions(restrictions["password_credential"].([]interface{})),
+	}
+}
+
+func expandAppManagementCredentialRestrictions(in []interface{}) *[]graph.CredentialRestriction {
+	result := make([]graph.CredentialRestriction, 0, len(in))
+
+	for _, raw := range in {
+		restriction := raw.(map[string]interface{})
+
+		properties := graph.CredentialRestriction{
+			RestrictionType: utils.String(restriction["restriction_type"].(string)),
+		}
+
+		if v := restriction["max_lifetime"].(string); v != "" {
+			properties.MaxLifetime = utils.String(v)
+		}
+
+		if v := restriction["restrict_for_apps_created_after"].(string); v != "" {
+			properties.RestrictForAppsCreatedAfterDateTime = utils.String(v)
+		}
+
+		result = append(result, properties)
+	}
+
+	return &result
+}
+
+func flattenAppManagementRestrictions(in *graph.AppManagementRestrictions) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_credential":      flattenAppManagementCredentialRestrictions(in.KeyCredentials),
+			"password_credential": flattenAppManagementCredentialRestrictions(in.PasswordCredentials),
+		},
+	}
+}
+
+func flattenAppManagementCredentialRestrictions(in *[]graph.CredentialRestriction) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, restriction := range *in {
+		restrictionType := ""
+		if restriction.RestrictionType != nil {
+			restrictionType = *restriction.RestrictionType
+		}
+
+		maxLifetime := ""
+		if restriction.MaxLifetime != nil {
+			maxLifetime = *restriction.MaxLifetime
+		}
+
+		restrictForAppsCreatedAfter := ""
+		if restriction.RestrictForAppsCreatedAfterDateTime != nil {
+			restrictForAppsCreatedAfter = *restriction.RestrictForAppsCreatedAfterDateTime
+		}
+
+		result = append(result, map[string]interface{}{
+			"restriction_type":                restrictionType,
+			"max_lifetime":                    maxLifetime,
+			"restrict_for_apps_created_after": restrictForAppsCreatedAfter,
+		})
+	}
+
+	return result
+}
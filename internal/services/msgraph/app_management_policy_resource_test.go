@@ -0,0 +1,147 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAppManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAppManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppManagementPolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAppManagementPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "restrictions.0.password_credential.0.restriction_type", "passwordLifetime"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAppManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAppManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppManagementPolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAppManagementPolicyExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccAppManagementPolicy_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAppManagementPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(data.ResourceName, "restrictions.0.key_credential.0.restriction_type", "asymmetricKeyLifetime"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAppManagementPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("App management policy %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving app management policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAppManagementPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_app_management_policy" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving app management policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("App management policy %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAppManagementPolicy_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctestPolicy-%[1]d"
+  description  = "Acceptance test app management policy"
+
+  restrictions {
+    password_credential {
+      restriction_type = "passwordLifetime"
+      max_lifetime     = "P90D"
+    }
+  }
+}
+`, ri)
+}
+
+func testAccAppManagementPolicy_complete(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctestPolicy-%[1]d"
+  description  = "Acceptance test app management policy"
+  enabled      = false
+
+  restrictions {
+    password_credential {
+      restriction_type = "passwordLifetime"
+      max_lifetime     = "P30D"
+    }
+
+    password_credential {
+      restriction_type                = "customPasswordAddition"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+
+    key_credential {
+      restriction_type = "asymmetricKeyLifetime"
+      max_lifetime     = "P180D"
+    }
+  }
+}
+`, ri)
+}
@@ -0,0 +1,147 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func appRoleAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: appRoleAssignmentResourceCreate,
+		Read:   appRoleAssignmentResourceRead,
+		Delete: appRoleAssignmentResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseAppRoleAssignmentId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"resource_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"app_role_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"principal_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"principal_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resource_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func appRoleAssignmentResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppRoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	resourceID := d.Get("resource_object_id").(string)
+
+	properties := graph.AppRoleAssignment{
+		AppRoleId:   utils.String(d.Get("app_role_id").(string)),
+		PrincipalId: utils.String(d.Get("principal_object_id").(string)),
+		ResourceId:  utils.String(resourceID),
+	}
+
+	assignment, _, err := client.Assign(ctx, resourceID, properties)
+	if err != nil {
+		return fmt.Errorf("creating App Role Assignment for resource %q: %+v", resourceID, err)
+	}
+
+	if assignment.ID == nil || *assignment.ID == "" {
+		return fmt.Errorf("App Role Assignment ID is empty or nil")
+	}
+
+	id := graph.AppRoleAssignmentIdFrom(resourceID, *assignment.ID)
+	d.SetId(id.String())
+
+	return appRoleAssignmentResourceRead(d, meta)
+}
+
+func appRoleAssignmentResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppRoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseAppRoleAssignmentId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	assignments, err := client.ListAssignedTo(ctx, id.ResourceId)
+	if err != nil {
+		return fmt.Errorf("listing App Role Assignments for resource %q: %+v", id.ResourceId, err)
+	}
+
+	var assignment *graph.AppRoleAssignment
+	for i, v := range assignments {
+		if v.ID != nil && strings.EqualFold(*v.ID, id.AssignmentId) {
+			assignment = &assignments[i]
+			break
+		}
+	}
+
+	if assignment == nil {
+		log.Printf("[DEBUG] App Role Assignment %q was not found for resource %q - removing from state", id.AssignmentId, id.ResourceId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_object_id", id.ResourceId)
+	d.Set("principal_object_id", assignment.PrincipalId)
+	d.Set("app_role_id", assignment.AppRoleId)
+	d.Set("principal_display_name", assignment.PrincipalDisplayName)
+	d.Set("principal_type", assignment.PrincipalType)
+	d.Set("resource_display_name", assignment.ResourceDisplayName)
+
+	return nil
+}
+
+func appRoleAssignmentResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppRoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseAppRoleAssignmentId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	if _, err := client.Remove(ctx, id.ResourceId, id.AssignmentId); err != nil {
+		return fmt.Errorf("deleting App Role Assignment %q for resource %q: %+v", id.AssignmentId, id.ResourceId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccAppRoleAssignment_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_role_assignment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAppRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAssignment_group(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "resource_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "principal_object_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "principal_type", "Group"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAppRoleAssignmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_app_role_assignment" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AppRoleAssignmentsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseAppRoleAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		assignments, err := client.ListAssignedTo(ctx, id.ResourceId)
+		if err != nil {
+			continue // the resource service principal was destroyed along with the assignment
+		}
+
+		for _, assignment := range assignments {
+			if assignment.ID != nil && *assignment.ID == id.AssignmentId {
+				return fmt.Errorf("App Role Assignment still exists:\n%#v", assignment)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAppRoleAssignment_group(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "Admins can perform all task actions"
+    display_name         = "Admin"
+    is_enabled           = true
+    value                = "Admin"
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_app_role_assignment" "test" {
+  resource_object_id  = azuread_service_principal.test.id
+  principal_object_id = azuread_group.test.id
+  app_role_id         = tolist(azuread_application.test.app_role)[0].id
+}
+`, id)
+}
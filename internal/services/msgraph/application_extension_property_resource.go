@@ -0,0 +1,158 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func applicationExtensionPropertyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: applicationExtensionPropertyResourceCreate,
+		Read:   applicationExtensionPropertyResourceRead,
+		Delete: applicationExtensionPropertyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseExtensionPropertyId(id)
+			return err
+		}),
+
+		// extension properties are immutable, so all properties force a new resource
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"data_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					graph.ExtensionPropertyDataTypeBinary,
+					graph.ExtensionPropertyDataTypeBoolean,
+					graph.ExtensionPropertyDataTypeDateTime,
+					graph.ExtensionPropertyDataTypeInteger,
+					graph.ExtensionPropertyDataTypeLargeInteger,
+					graph.ExtensionPropertyDataTypeString,
+				}, false),
+			},
+
+			"target_objects": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			// the fully qualified name in the form extension_{appId}_{name}, as used
+			// when addressing the property on directory objects
+			"qualified_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationExtensionPropertyResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	applicationId := d.Get("application_object_id").(string)
+	name := d.Get("name").(string)
+
+	properties := graph.ExtensionProperty{
+		Name:          utils.String(name),
+		DataType:      utils.String(d.Get("data_type").(string)),
+		TargetObjects: tf.ExpandStringSlicePtr(d.Get("target_objects").([]interface{})),
+	}
+
+	property, _, err := client.CreateExtensionProperty(ctx, applicationId, properties)
+	if err != nil {
+		return fmt.Errorf("creating extension property %q for application with object ID %q: %+v", name, applicationId, err)
+	}
+
+	if property.ID == nil {
+		return fmt.Errorf("nil ID returned for extension property %q", name)
+	}
+
+	d.SetId(graph.ExtensionPropertyIdFrom(applicationId, *property.ID).String())
+
+	return applicationExtensionPropertyResourceRead(d, meta)
+}
+
+func applicationExtensionPropertyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseExtensionPropertyId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Extension Property ID %q: %+v", d.Id(), err)
+	}
+
+	property, status, err := client.GetExtensionProperty(ctx, id.ApplicationId, id.PropertyId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Extension property %q was not found for application %q - removing from state", id.PropertyId, id.ApplicationId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving extension property %q for application with object ID %q: %+v", id.PropertyId, id.ApplicationId, err)
+	}
+
+	d.Set("application_object_id", id.ApplicationId)
+	d.Set("data_type", property.DataType)
+	d.Set("qualified_name", property.Name)
+
+	if property.TargetObjects != nil {
+		d.Set("target_objects", *property.TargetObjects)
+	}
+
+	if _, ok := d.GetOk("name"); !ok {
+		// the short name is not returned by the API, so fall back to the qualified name on import
+		d.Set("name", property.Name)
+	}
+
+	return nil
+}
+
+func applicationExtensionPropertyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseExtensionPropertyId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Extension Property ID %q: %+v", d.Id(), err)
+	}
+
+	if status, err := client.DeleteExtensionProperty(ctx, id.ApplicationId, id.PropertyId); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting extension property %q for application with object ID %q: %+v", id.PropertyId, id.ApplicationId, err)
+		}
+	}
+
+	return nil
+}
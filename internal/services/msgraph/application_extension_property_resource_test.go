@@ -0,0 +1,102 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccApplicationExtensionProperty_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_extension_property", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationExtensionPropertyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationExtensionProperty_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationExtensionPropertyExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "qualified_name"),
+				),
+			},
+			data.ImportStep("name"),
+		},
+	})
+}
+
+func testCheckApplicationExtensionPropertyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		id, err := graph.ParseExtensionPropertyId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("parsing Extension Property ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.GetExtensionProperty(ctx, id.ApplicationId, id.PropertyId); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Extension property %q does not exist for application %q", id.PropertyId, id.ApplicationId)
+			}
+			return fmt.Errorf("retrieving extension property %q: %+v", id.PropertyId, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckApplicationExtensionPropertyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_application_extension_property" {
+			continue
+		}
+
+		id, err := graph.ParseExtensionPropertyId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("parsing Extension Property ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.GetExtensionProperty(ctx, id.ApplicationId, id.PropertyId); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving extension property %q: %+v", id.PropertyId, err)
+		}
+
+		return fmt.Errorf("Extension property %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApplicationExtensionProperty_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_application_extension_property" "test" {
+  application_object_id = azuread_application.test.object_id
+  name                  = "jobGroup%[1]d"
+  data_type             = "String"
+  target_objects        = ["User"]
+}
+`, ri)
+}
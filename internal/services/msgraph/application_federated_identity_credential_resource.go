@@ -0,0 +1,220 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+const applicationFederatedIdentityCredentialResourceName = "azuread_application_federated_identity_credential"
+
+func applicationFederatedIdentityCredentialResource() *schema.Resource {
+	return &schema.Resource{
+		Create: applicationFederatedIdentityCredentialResourceCreate,
+		Read:   applicationFederatedIdentityCredentialResourceRead,
+		Update: applicationFederatedIdentityCredentialResourceUpdate,
+		Delete: applicationFederatedIdentityCredentialResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseFederatedIdentityCredentialId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"audiences": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"issuer": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"subject": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"credential_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationFederatedIdentityCredentialResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	applicationId := d.Get("application_object_id").(string)
+
+	tf.LockByName(applicationFederatedIdentityCredentialResourceName, applicationId)
+	defer tf.UnlockByName(applicationFederatedIdentityCredentialResourceName, applicationId)
+
+	displayName := d.Get("display_name").(string)
+
+	existing, _, err := client.ListFederatedIdentityCredentials(ctx, applicationId)
+	if err != nil {
+		return fmt.Errorf("listing Federated Identity Credentials for Application with ID %q: %+v", applicationId, err)
+	}
+
+	for _, credential := range existing {
+		if credential.Name != nil && *credential.Name == displayName && credential.ID != nil {
+			return tf.ImportAsExistsError("azuread_application_federated_identity_credential", graph.FederatedIdentityCredentialIdFrom(applicationId, *credential.ID).String())
+		}
+	}
+
+	properties := graph.FederatedIdentityCredential{
+		Audiences: expandFederatedIdentityCredentialAudiences(d.Get("audiences").([]interface{})),
+		Issuer:    utils.String(d.Get("issuer").(string)),
+		Name:      utils.String(displayName),
+		Subject:   utils.String(d.Get("subject").(string)),
+	}
+
+	if description, ok := d.GetOk("description"); ok {
+		properties.Description = utils.String(description.(string))
+	}
+
+	credential, _, err := client.CreateFederatedIdentityCredential(ctx, applicationId, properties)
+	if err != nil {
+		return fmt.Errorf("creating Federated Identity Credential %q for Application with ID %q: %+v", displayName, applicationId, err)
+	}
+
+	if credential.ID == nil || *credential.ID == "" {
+		return fmt.Errorf("Federated Identity Credential ID is empty or nil")
+	}
+
+	d.SetId(graph.FederatedIdentityCredentialIdFrom(applicationId, *credential.ID).String())
+
+	return applicationFederatedIdentityCredentialResourceRead(d, meta)
+}
+
+func applicationFederatedIdentityCredentialResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseFederatedIdentityCredentialId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Federated Identity Credential ID: %v", err)
+	}
+
+	tf.LockByName(applicationFederatedIdentityCredentialResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationFederatedIdentityCredentialResourceName, id.ApplicationId)
+
+	properties := graph.FederatedIdentityCredential{
+		Audiences: expandFederatedIdentityCredentialAudiences(d.Get("audiences").([]interface{})),
+		Issuer:    utils.String(d.Get("issuer").(string)),
+		Subject:   utils.String(d.Get("subject").(string)),
+	}
+
+	if d.HasChange("description") {
+		properties.Description = utils.String(d.Get("description").(string))
+	}
+
+	if _, err := client.UpdateFederatedIdentityCredential(ctx, id.ApplicationId, id.CredentialId, properties); err != nil {
+		return fmt.Errorf("updating Federated Identity Credential with ID %q: %+v", d.Id(), err)
+	}
+
+	return applicationFederatedIdentityCredentialResourceRead(d, meta)
+}
+
+func applicationFederatedIdentityCredentialResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseFederatedIdentityCredentialId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Federated Identity Credential ID: %v", err)
+	}
+
+	credential, status, err := client.GetFederatedIdentityCredential(ctx, id.ApplicationId, id.CredentialId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Federated Identity Credential with ID %q was not found - removing from state!", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Federated Identity Credential with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("application_object_id", id.ApplicationId)
+	d.Set("credential_id", id.CredentialId)
+	d.Set("display_name", credential.Name)
+	d.Set("description", credential.Description)
+	d.Set("issuer", credential.Issuer)
+	d.Set("subject", credential.Subject)
+
+	audiences := make([]string, 0)
+	if credential.Audiences != nil {
+		audiences = *credential.Audiences
+	}
+	if err := d.Set("audiences", audiences); err != nil {
+		return fmt.Errorf("setting `audiences`: %+v", err)
+	}
+
+	return nil
+}
+
+func applicationFederatedIdentityCredentialResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseFederatedIdentityCredentialId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Federated Identity Credential ID: %v", err)
+	}
+
+	tf.LockByName(applicationFederatedIdentityCredentialResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationFederatedIdentityCredentialResourceName, id.ApplicationId)
+
+	if _, err := client.DeleteFederatedIdentityCredential(ctx, id.ApplicationId, id.CredentialId); err != nil {
+		return fmt.Errorf("deleting Federated Identity Credential with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFederatedIdentityCredentialAudiences(in []interface{}) *[]string {
+	audiences := make([]string, 0, len(in))
+	for _, audience := range in {
+		audiences = append(audiences, audience.(string))
+	}
+	return &audiences
+}
@@ -0,0 +1,149 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccApplicationFederatedIdentityCredential_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationFederatedIdentityCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationFederatedIdentityCredential_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationFederatedIdentityCredentialExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "credential_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccApplicationFederatedIdentityCredential_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationFederatedIdentityCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationFederatedIdentityCredential_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationFederatedIdentityCredentialExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccApplicationFederatedIdentityCredential_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationFederatedIdentityCredentialExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "subject", fmt.Sprintf("repo:acctest-%d/example:ref:refs/heads/main", data.RandomInteger)),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckApplicationFederatedIdentityCredentialExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseFederatedIdentityCredentialId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		if _, status, err := client.GetFederatedIdentityCredential(ctx, id.ApplicationId, id.CredentialId); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Federated Identity Credential %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("Bad: Get on ApplicationsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckApplicationFederatedIdentityCredentialDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_application_federated_identity_credential" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseFederatedIdentityCredentialId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		credential, status, err := client.GetFederatedIdentityCredential(ctx, id.ApplicationId, id.CredentialId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Federated Identity Credential still exists:\n%#v", credential)
+	}
+
+	return nil
+}
+
+func testAccApplicationFederatedIdentityCredential_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_object_id = azuread_application.test.id
+  display_name          = "acctest-fic-%[1]d"
+  audiences             = ["api://AzureADTokenExchange"]
+  issuer                = "https://token.actions.githubusercontent.com"
+  subject               = "repo:acctest-%[1]d/example:pull_request"
+}
+`, id)
+}
+
+func testAccApplicationFederatedIdentityCredential_complete(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_object_id = azuread_application.test.id
+  display_name          = "acctest-fic-%[1]d"
+  description           = "Federated credential for acceptance testing"
+  audiences             = ["api://AzureADTokenExchange"]
+  issuer                = "https://token.actions.githubusercontent.com"
+  subject               = "repo:acctest-%[1]d/example:ref:refs/heads/main"
+}
+`, id)
+}
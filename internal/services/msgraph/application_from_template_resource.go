@@ -0,0 +1,128 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func applicationFromTemplateResource() *schema.Resource {
+	return &schema.Resource{
+		Create: applicationFromTemplateResourceCreate,
+		Read:   applicationFromTemplateResourceRead,
+		Delete: applicationFromTemplateResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseApplicationFromTemplateId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"application_object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_principal_object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationFromTemplateResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationTemplatesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	templateId := d.Get("template_id").(string)
+
+	result, _, err := client.Instantiate(ctx, templateId, d.Get("display_name").(string))
+	if err != nil {
+		return fmt.Errorf("instantiating application from template %q: %+v", templateId, err)
+	}
+	if result == nil || result.Application == nil || result.Application.ID == nil || *result.Application.ID == "" {
+		return fmt.Errorf("nil/blank application ID returned when instantiating template %q", templateId)
+	}
+	if result.ServicePrincipal == nil || result.ServicePrincipal.ID == nil || *result.ServicePrincipal.ID == "" {
+		return fmt.Errorf("nil/blank service principal ID returned when instantiating template %q", templateId)
+	}
+
+	d.SetId(graph.ApplicationFromTemplateIdFrom(*result.Application.ID, *result.ServicePrincipal.ID).String())
+
+	return applicationFromTemplateResourceRead(d, meta)
+}
+
+func applicationFromTemplateResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseApplicationFromTemplateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	app, status, err := client.Get(ctx, id.ApplicationId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application %q was not found - removing from state", id.ApplicationId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Application %q: %+v", id.ApplicationId, err)
+	}
+
+	d.Set("display_name", app.DisplayName)
+	d.Set("application_object_id", app.ID)
+	d.Set("application_id", app.AppId)
+	d.Set("service_principal_object_id", id.ServicePrincipalId)
+
+	return nil
+}
+
+// applicationFromTemplateResourceDelete removes the application; the directory also
+// removes the associated service principal when its application is deleted.
+func applicationFromTemplateResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseApplicationFromTemplateId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if status, err := client.Delete(ctx, id.ApplicationId); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Application %q: %+v", id.ApplicationId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+// testApplicationTemplateId is the template ID of the "custom" non-gallery application
+// template, which is available in every tenant.
+const testApplicationTemplateId = "8adf8e6e-67b2-4cf2-a259-e3dc5476c621"
+
+func TestAccApplicationFromTemplate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_from_template", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationFromTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationFromTemplate_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationFromTemplateExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestApp-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "application_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "service_principal_object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckApplicationFromTemplateExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		id, err := graph.ParseApplicationFromTemplateId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, id.ApplicationId); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Application %q does not exist", id.ApplicationId)
+			}
+			return fmt.Errorf("retrieving Application %q: %+v", id.ApplicationId, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckApplicationFromTemplateDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_application_from_template" {
+			continue
+		}
+
+		id, err := graph.ParseApplicationFromTemplateId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		app, status, err := client.Get(ctx, id.ApplicationId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Application still exists:\n%#v", app)
+	}
+
+	return nil
+}
+
+func testAccApplicationFromTemplate_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application_from_template" "test" {
+  display_name = "acctestApp-%[1]d"
+  template_id  = "%[2]s"
+}
+`, id, testApplicationTemplateId)
+}
@@ -0,0 +1,252 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+const applicationPreAuthorizedResourceName = "azuread_application_pre_authorized"
+
+func applicationPreAuthorizedResource() *schema.Resource {
+	return &schema.Resource{
+		Create: applicationPreAuthorizedResourceCreate,
+		Read:   applicationPreAuthorizedResourceRead,
+		Update: applicationPreAuthorizedResourceUpdate,
+		Delete: applicationPreAuthorizedResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParsePreAuthorizedApplicationId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"authorized_app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"permission_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+		},
+	}
+}
+
+func applicationPreAuthorizedResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id := graph.PreAuthorizedApplicationIdFrom(d.Get("application_object_id").(string), d.Get("authorized_app_id").(string))
+
+	tf.LockByName(applicationPreAuthorizedResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationPreAuthorizedResourceName, id.ObjectId)
+
+	app, _, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		return fmt.Errorf("retrieving Application with ID %q: %+v", id.ObjectId, err)
+	}
+
+	newPreAuthorizedApps := make([]graph.PreAuthorizedApplication, 0)
+	if app.Api != nil && app.Api.PreAuthorizedApplications != nil {
+		for _, a := range *app.Api.PreAuthorizedApplications {
+			if a.AppId != nil && *a.AppId == id.AppId {
+				return tf.ImportAsExistsError("azuread_application_pre_authorized", id.String())
+			}
+			newPreAuthorizedApps = append(newPreAuthorizedApps, a)
+		}
+	}
+
+	newPreAuthorizedApps = append(newPreAuthorizedApps, graph.PreAuthorizedApplication{
+		AppId:                  utils.String(id.AppId),
+		DelegatedPermissionIds: expandPreAuthorizedPermissionIds(d.Get("permission_ids").(*schema.Set).List()),
+	})
+
+	properties := graph.Application{
+		Api: &graph.ApplicationApi{
+			PreAuthorizedApplications: &newPreAuthorizedApps,
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ObjectId, properties); err != nil {
+		return fmt.Errorf("authorizing Application with app ID %q for Application with ID %q: %+v", id.AppId, id.ObjectId, err)
+	}
+
+	d.SetId(id.String())
+
+	return applicationPreAuthorizedResourceRead(d, meta)
+}
+
+func applicationPreAuthorizedResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParsePreAuthorizedApplicationId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Pre-Authorized Application ID: %v", err)
+	}
+
+	tf.LockByName(applicationPreAuthorizedResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationPreAuthorizedResourceName, id.ObjectId)
+
+	app, _, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		return fmt.Errorf("retrieving Application with ID %q: %+v", id.ObjectId, err)
+	}
+
+	if app.Api == nil || app.Api.PreAuthorizedApplications == nil {
+		return fmt.Errorf("no pre-authorized applications found for Application with ID %q", id.ObjectId)
+	}
+
+	found := false
+	newPreAuthorizedApps := make([]graph.PreAuthorizedApplication, 0)
+	for _, a := range *app.Api.PreAuthorizedApplications {
+		if a.AppId != nil && *a.AppId == id.AppId {
+			found = true
+			a.DelegatedPermissionIds = expandPreAuthorizedPermissionIds(d.Get("permission_ids").(*schema.Set).List())
+		}
+		newPreAuthorizedApps = append(newPreAuthorizedApps, a)
+	}
+
+	if !found {
+		return fmt.Errorf("pre-authorized application with app ID %q was not found for Application with ID %q", id.AppId, id.ObjectId)
+	}
+
+	properties := graph.Application{
+		Api: &graph.ApplicationApi{
+			PreAuthorizedApplications: &newPreAuthorizedApps,
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ObjectId, properties); err != nil {
+		return fmt.Errorf("updating pre-authorized application with app ID %q for Application with ID %q: %+v", id.AppId, id.ObjectId, err)
+	}
+
+	return applicationPreAuthorizedResourceRead(d, meta)
+}
+
+func applicationPreAuthorizedResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParsePreAuthorizedApplicationId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Pre-Authorized Application ID: %v", err)
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with ID %q was not found - removing from state!", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Application with ID %q: %+v", id.ObjectId, err)
+	}
+
+	var preAuthorizedApp *graph.PreAuthorizedApplication
+	if app.Api != nil && app.Api.PreAuthorizedApplications != nil {
+		for i, a := range *app.Api.PreAuthorizedApplications {
+			if a.AppId != nil && *a.AppId == id.AppId {
+				preAuthorizedApp = &(*app.Api.PreAuthorizedApplications)[i]
+				break
+			}
+		}
+	}
+
+	if preAuthorizedApp == nil {
+		log.Printf("[DEBUG] Application with app ID %q was not pre-authorized for Application with ID %q - removing from state!", id.AppId, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_object_id", id.ObjectId)
+	d.Set("authorized_app_id", id.AppId)
+
+	permissionIds := make([]string, 0)
+	if preAuthorizedApp.DelegatedPermissionIds != nil {
+		permissionIds = *preAuthorizedApp.DelegatedPermissionIds
+	}
+	if err := d.Set("permission_ids", permissionIds); err != nil {
+		return fmt.Errorf("setting `permission_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func applicationPreAuthorizedResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParsePreAuthorizedApplicationId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Pre-Authorized Application ID: %v", err)
+	}
+
+	tf.LockByName(applicationPreAuthorizedResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationPreAuthorizedResourceName, id.ObjectId)
+
+	app, status, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			// the parent application was deleted along with its pre-authorized applications
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Application with ID %q: %+v", id.ObjectId, err)
+	}
+
+	newPreAuthorizedApps := make([]graph.PreAuthorizedApplication, 0)
+	if app.Api != nil && app.Api.PreAuthorizedApplications != nil {
+		for _, a := range *app.Api.PreAuthorizedApplications {
+			if a.AppId != nil && *a.AppId == id.AppId {
+				continue
+			}
+			newPreAuthorizedApps = append(newPreAuthorizedApps, a)
+		}
+	}
+
+	properties := graph.Application{
+		Api: &graph.ApplicationApi{
+			PreAuthorizedApplications: &newPreAuthorizedApps,
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ObjectId, properties); err != nil {
+		return fmt.Errorf("removing pre-authorized application with app ID %q for Application with ID %q: %+v", id.AppId, id.ObjectId, err)
+	}
+
+	return nil
+}
+
+func expandPreAuthorizedPermissionIds(in []interface{}) *[]string {
+	permissionIds := make([]string, 0, len(in))
+	for _, permissionId := range in {
+		permissionIds = append(permissionIds, permissionId.(string))
+	}
+	return &permissionIds
+}
@@ -0,0 +1,119 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccApplicationPreAuthorized_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_pre_authorized", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationPreAuthorizedDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationPreAuthorized_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationPreAuthorizedExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "permission_ids.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckApplicationPreAuthorizedExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParsePreAuthorizedApplicationId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		app, _, err := client.Get(ctx, id.ObjectId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on ApplicationsClient: %+v", err)
+		}
+
+		if app.Api != nil && app.Api.PreAuthorizedApplications != nil {
+			for _, a := range *app.Api.PreAuthorizedApplications {
+				if a.AppId != nil && *a.AppId == id.AppId {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Pre-Authorized Application %q does not exist", rs.Primary.ID)
+	}
+}
+
+func testCheckApplicationPreAuthorizedDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_application_pre_authorized" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParsePreAuthorizedApplicationId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		app, status, err := client.Get(ctx, id.ObjectId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue // the parent application was destroyed along with the authorization
+			}
+
+			return err
+		}
+
+		if app.Api != nil && app.Api.PreAuthorizedApplications != nil {
+			for _, a := range *app.Api.PreAuthorizedApplications {
+				if a.AppId != nil && *a.AppId == id.AppId {
+					return fmt.Errorf("Pre-Authorized Application still exists:\n%#v", a)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccApplicationPreAuthorized_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "authorized" {
+  name = "acctestApp-authorized-%[1]d"
+}
+
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_application_pre_authorized" "test" {
+  application_object_id = azuread_application.test.id
+  authorized_app_id     = azuread_application.authorized.application_id
+  permission_ids        = [tolist(azuread_application.test.oauth2_permissions)[0].id]
+}
+`, id)
+}
@@ -0,0 +1,206 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+const applicationRedirectUrisResourceName = "azuread_application_redirect_uris"
+
+func applicationRedirectUrisResource() *schema.Resource {
+	return &schema.Resource{
+		Create: applicationRedirectUrisResourceCreate,
+		Read:   applicationRedirectUrisResourceRead,
+		Update: applicationRedirectUrisResourceUpdate,
+		Delete: applicationRedirectUrisResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseRedirectUrisId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					graph.RedirectUriTypePublicClient,
+					graph.RedirectUriTypeSpa,
+					graph.RedirectUriTypeWeb,
+				}, false),
+			},
+
+			"redirect_uris": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func applicationRedirectUrisResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id := graph.RedirectUrisIdFrom(d.Get("application_object_id").(string), d.Get("type").(string))
+
+	tf.LockByName(applicationRedirectUrisResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationRedirectUrisResourceName, id.ObjectId)
+
+	app, _, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		return fmt.Errorf("retrieving Application with ID %q: %+v", id.ObjectId, err)
+	}
+
+	if existing := applicationRedirectUrisForType(app, id.UriType); len(existing) > 0 {
+		return tf.ImportAsExistsError("azuread_application_redirect_uris", id.String())
+	}
+
+	properties := expandApplicationRedirectUris(id.UriType, d.Get("redirect_uris").(*schema.Set).List())
+	if _, err := client.Update(ctx, id.ObjectId, properties); err != nil {
+		return fmt.Errorf("setting %s redirect URIs for Application with ID %q: %+v", id.UriType, id.ObjectId, err)
+	}
+
+	d.SetId(id.String())
+
+	return applicationRedirectUrisResourceRead(d, meta)
+}
+
+func applicationRedirectUrisResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseRedirectUrisId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Redirect URIs ID: %v", err)
+	}
+
+	tf.LockByName(applicationRedirectUrisResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationRedirectUrisResourceName, id.ObjectId)
+
+	properties := expandApplicationRedirectUris(id.UriType, d.Get("redirect_uris").(*schema.Set).List())
+	if _, err := client.Update(ctx, id.ObjectId, properties); err != nil {
+		return fmt.Errorf("updating %s redirect URIs for Application with ID %q: %+v", id.UriType, id.ObjectId, err)
+	}
+
+	return applicationRedirectUrisResourceRead(d, meta)
+}
+
+func applicationRedirectUrisResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseRedirectUrisId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Redirect URIs ID: %v", err)
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with ID %q was not found - removing from state!", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Application with ID %q: %+v", id.ObjectId, err)
+	}
+
+	redirectUris := applicationRedirectUrisForType(app, id.UriType)
+	if len(redirectUris) == 0 {
+		log.Printf("[DEBUG] No %s redirect URIs found for Application with ID %q - removing from state!", id.UriType, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_object_id", id.ObjectId)
+	d.Set("type", id.UriType)
+
+	if err := d.Set("redirect_uris", redirectUris); err != nil {
+		return fmt.Errorf("setting `redirect_uris`: %+v", err)
+	}
+
+	return nil
+}
+
+func applicationRedirectUrisResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseRedirectUrisId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Redirect URIs ID: %v", err)
+	}
+
+	tf.LockByName(applicationRedirectUrisResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationRedirectUrisResourceName, id.ObjectId)
+
+	properties := expandApplicationRedirectUris(id.UriType, []interface{}{})
+	if _, err := client.Update(ctx, id.ObjectId, properties); err != nil {
+		return fmt.Errorf("removing %s redirect URIs for Application with ID %q: %+v", id.UriType, id.ObjectId, err)
+	}
+
+	return nil
+}
+
+func applicationRedirectUrisForType(app *graph.Application, uriType string) []string {
+	redirectUris := make([]string, 0)
+
+	switch uriType {
+	case graph.RedirectUriTypePublicClient:
+		if app.PublicClient != nil && app.PublicClient.RedirectUris != nil {
+			redirectUris = *app.PublicClient.RedirectUris
+		}
+	case graph.RedirectUriTypeSpa:
+		if app.Spa != nil && app.Spa.RedirectUris != nil {
+			redirectUris = *app.Spa.RedirectUris
+		}
+	case graph.RedirectUriTypeWeb:
+		if app.Web != nil && app.Web.RedirectUris != nil {
+			redirectUris = *app.Web.RedirectUris
+		}
+	}
+
+	return redirectUris
+}
+
+func expandApplicationRedirectUris(uriType string, in []interface{}) graph.Application {
+	redirectUris := make([]string, 0, len(in))
+	for _, uri := range in {
+		redirectUris = append(redirectUris, uri.(string))
+	}
+
+	var properties graph.Application
+	switch uriType {
+	case graph.RedirectUriTypePublicClient:
+		properties.PublicClient = &graph.ApplicationPublicClient{RedirectUris: &redirectUris}
+	case graph.RedirectUriTypeSpa:
+		properties.Spa = &graph.ApplicationSpa{RedirectUris: &redirectUris}
+	case graph.RedirectUriTypeWeb:
+		properties.Web = &graph.ApplicationWeb{RedirectUris: &redirectUris}
+	}
+
+	return properties
+}
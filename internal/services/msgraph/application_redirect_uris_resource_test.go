@@ -0,0 +1,167 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccApplicationRedirectUris_web(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_redirect_uris", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationRedirectUrisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationRedirectUris_web(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationRedirectUrisExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "type", "web"),
+					resource.TestCheckResourceAttr(data.ResourceName, "redirect_uris.#", "2"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccApplicationRedirectUris_publicClient(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_redirect_uris", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationRedirectUrisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationRedirectUris_publicClient(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationRedirectUrisExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "type", "public_client"),
+					resource.TestCheckResourceAttr(data.ResourceName, "redirect_uris.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckApplicationRedirectUrisExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseRedirectUrisId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		app, _, err := client.Get(ctx, id.ObjectId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on ApplicationsClient: %+v", err)
+		}
+
+		if len(testApplicationRedirectUris(app, id.UriType)) == 0 {
+			return fmt.Errorf("No %s redirect URIs exist for Application %q", id.UriType, id.ObjectId)
+		}
+
+		return nil
+	}
+}
+
+func testCheckApplicationRedirectUrisDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_application_redirect_uris" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseRedirectUrisId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		app, status, err := client.Get(ctx, id.ObjectId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue // the parent application was destroyed along with the redirect URIs
+			}
+
+			return err
+		}
+
+		if uris := testApplicationRedirectUris(app, id.UriType); len(uris) > 0 {
+			return fmt.Errorf("Redirect URIs still exist:\n%#v", uris)
+		}
+	}
+
+	return nil
+}
+
+func testApplicationRedirectUris(app *graph.Application, uriType string) []string {
+	switch uriType {
+	case graph.RedirectUriTypePublicClient:
+		if app.PublicClient != nil && app.PublicClient.RedirectUris != nil {
+			return *app.PublicClient.RedirectUris
+		}
+	case graph.RedirectUriTypeSpa:
+		if app.Spa != nil && app.Spa.RedirectUris != nil {
+			return *app.Spa.RedirectUris
+		}
+	case graph.RedirectUriTypeWeb:
+		if app.Web != nil && app.Web.RedirectUris != nil {
+			return *app.Web.RedirectUris
+		}
+	}
+	return nil
+}
+
+func testAccApplicationRedirectUris_web(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_application_redirect_uris" "test" {
+  application_object_id = azuread_application.test.id
+  type                  = "web"
+
+  redirect_uris = [
+    "https://app.hashicorptest.com/account",
+    "https://app.hashicorptest.com/account/preview-%[1]d",
+  ]
+}
+`, id)
+}
+
+func testAccApplicationRedirectUris_publicClient(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+  type = "native"
+}
+
+resource "azuread_application_redirect_uris" "test" {
+  application_object_id = azuread_application.test.id
+  type                  = "public_client"
+
+  redirect_uris = ["urn:ietf:wg:oauth:2.0:oob"]
+}
+`, id)
+}
@@ -0,0 +1,106 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func applicationVerifiedPublisherResource() *schema.Resource {
+	return &schema.Resource{
+		Create: applicationVerifiedPublisherResourceCreate,
+		Read:   applicationVerifiedPublisherResourceRead,
+		Update: applicationVerifiedPublisherResourceCreate,
+		Delete: applicationVerifiedPublisherResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"verified_publisher_mpn_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationVerifiedPublisherResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	applicationId := d.Get("application_object_id").(string)
+	mpnId := d.Get("verified_publisher_mpn_id").(string)
+
+	if _, err := client.SetVerifiedPublisher(ctx, applicationId, mpnId); err != nil {
+		return fmt.Errorf("setting verified publisher %q for application with object ID %q: %+v", mpnId, applicationId, err)
+	}
+
+	d.SetId(applicationId)
+
+	return applicationVerifiedPublisherResourceRead(d, meta)
+}
+
+func applicationVerifiedPublisherResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	publisher, status, err := client.GetVerifiedPublisher(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving verified publisher for application with object ID %q: %+v", d.Id(), err)
+	}
+
+	if publisher == nil || publisher.VerifiedPublisherId == nil {
+		log.Printf("[DEBUG] Application with object ID %q no longer has a verified publisher - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_object_id", d.Id())
+	d.Set("verified_publisher_mpn_id", publisher.VerifiedPublisherId)
+	d.Set("display_name", publisher.DisplayName)
+
+	return nil
+}
+
+func applicationVerifiedPublisherResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ApplicationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.UnsetVerifiedPublisher(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("unsetting verified publisher for application with object ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
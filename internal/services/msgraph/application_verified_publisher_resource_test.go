@@ -0,0 +1,101 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccApplicationVerifiedPublisher_basic(t *testing.T) {
+	// requires a Microsoft Partner Network ID associated with a verified domain of the tenant
+	mpnId := os.Getenv("ARM_TEST_MPN_ID")
+	if mpnId == "" {
+		t.Skip("skipping as ARM_TEST_MPN_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application_verified_publisher", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckApplicationVerifiedPublisherDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationVerifiedPublisher_basic(data.RandomInteger, mpnId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationVerifiedPublisherExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "verified_publisher_mpn_id", mpnId),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckApplicationVerifiedPublisherExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		publisher, _, err := client.GetVerifiedPublisher(ctx, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("retrieving verified publisher for application with object ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		if publisher == nil || publisher.VerifiedPublisherId == nil {
+			return fmt.Errorf("application with object ID %q has no verified publisher", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckApplicationVerifiedPublisherDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_application_verified_publisher" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.ApplicationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		publisher, status, err := client.GetVerifiedPublisher(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving verified publisher for application with object ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		if publisher != nil && publisher.VerifiedPublisherId != nil {
+			return fmt.Errorf("application with object ID %q still has a verified publisher", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccApplicationVerifiedPublisher_basic(ri int, mpnId string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_application_verified_publisher" "test" {
+  application_object_id     = azuread_application.test.object_id
+  verified_publisher_mpn_id = "%[2]s"
+}
+`, ri, mpnId)
+}
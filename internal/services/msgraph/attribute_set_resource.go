@@ -0,0 +1,131 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+func attributeSetResource() *schema.Resource {
+	return &schema.Resource{
+		Create: attributeSetResourceCreate,
+		Read:   attributeSetResourceRead,
+		Update: attributeSetResourceUpdate,
+		Delete: attributeSetResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the name of the attribute set")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"max_attributes_per_set": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+		},
+	}
+}
+
+func attributeSetResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	name := d.Get("name").(string)
+
+	properties := graph.AttributeSet{
+		ID:          utils.String(name),
+		Description: utils.String(d.Get("description").(string)),
+	}
+
+	if v, ok := d.GetOk("max_attributes_per_set"); ok {
+		properties.MaxAttributesPerSet = utils.Int32(int32(v.(int)))
+	}
+
+	attributeSet, _, err := client.CreateAttributeSet(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Attribute Set %q: %+v", name, err)
+	}
+	if attributeSet == nil || attributeSet.ID == nil || *attributeSet.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Attribute Set")
+	}
+
+	d.SetId(*attributeSet.ID)
+
+	return attributeSetResourceRead(d, meta)
+}
+
+func attributeSetResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AttributeSet{
+		Description: utils.String(d.Get("description").(string)),
+	}
+
+	if v, ok := d.GetOk("max_attributes_per_set"); ok {
+		properties.MaxAttributesPerSet = utils.Int32(int32(v.(int)))
+	}
+
+	if _, err := client.UpdateAttributeSet(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Attribute Set %q: %+v", d.Id(), err)
+	}
+
+	return attributeSetResourceRead(d, meta)
+}
+
+func attributeSetResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	attributeSet, status, err := client.GetAttributeSet(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Attribute Set %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Attribute Set %q: %+v", d.Id(), err)
+	}
+
+	d.Set("name", attributeSet.ID)
+	d.Set("description", attributeSet.Description)
+
+	maxAttributes := 0
+	if attributeSet.MaxAttributesPerSet != nil {
+		maxAttributes = int(*attributeSet.MaxAttributesPerSet)
+	}
+	d.Set("max_attributes_per_set", maxAttributes)
+
+	return nil
+}
+
+func attributeSetResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	// attribute sets cannot be deleted from the directory, so deletion simply removes
+	// the set from state
+	log.Printf("[DEBUG] Attribute Set %q cannot be deleted - removing from state only", d.Id())
+	return nil
+}
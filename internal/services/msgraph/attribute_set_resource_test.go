@@ -0,0 +1,71 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAttributeSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_attribute_set", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAttributeSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeSet_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAttributeSetExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "name", fmt.Sprintf("acctestSet%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "max_attributes_per_set", "25"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAttributeSetExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.GetAttributeSet(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Attribute Set %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Attribute Set %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAttributeSetDestroy(s *terraform.State) error {
+	// attribute sets cannot be deleted from the directory, so there is nothing to
+	// verify here
+	return nil
+}
+
+func testAccAttributeSet_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name                   = "acctestSet%[1]d"
+  description            = "Acceptance test attribute set"
+  max_attributes_per_set = 25
+}
+`, id)
+}
@@ -0,0 +1,146 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+// authenticationContextClassReferenceIdRegex matches the fixed c1-c25 namespace
+// available for authentication context class references.
+var authenticationContextClassReferenceIdRegex = regexp.MustCompile(`^c([1-9]|1[0-9]|2[0-5])$`)
+
+func authenticationContextClassReferenceResource() *schema.Resource {
+	return &schema.Resource{
+		Create: authenticationContextClassReferenceResourceCreate,
+		Read:   authenticationContextClassReferenceResourceRead,
+		Update: authenticationContextClassReferenceResourceUpdate,
+		Delete: authenticationContextClassReferenceResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if !authenticationContextClassReferenceIdRegex.MatchString(id) {
+				return fmt.Errorf("specified ID (%q) must be in the range c1 through c25", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"class_reference_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(authenticationContextClassReferenceIdRegex,
+					"must be in the range c1 through c25"),
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// controls whether the context is published and selectable in Conditional
+			// Access policies and PIM role settings
+			"available": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func authenticationContextClassReferenceResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationContextClassReferencesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id := d.Get("class_reference_id").(string)
+
+	if _, status, err := client.Get(ctx, id); err == nil {
+		return tf.ImportAsExistsError("azuread_authentication_context_class_reference", id)
+	} else if status != http.StatusNotFound {
+		return fmt.Errorf("checking for existing authentication context class reference %q: %+v", id, err)
+	}
+
+	properties := graph.AuthenticationContextClassReference{
+		ID:          utils.String(id),
+		Description: utils.String(d.Get("description").(string)),
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		IsAvailable: utils.Bool(d.Get("available").(bool)),
+	}
+
+	if _, err := client.Update(ctx, id, properties); err != nil {
+		return fmt.Errorf("creating authentication context class reference %q: %+v", id, err)
+	}
+
+	d.SetId(id)
+
+	return authenticationContextClassReferenceResourceRead(d, meta)
+}
+
+func authenticationContextClassReferenceResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationContextClassReferencesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AuthenticationContextClassReference{
+		Description: utils.String(d.Get("description").(string)),
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		IsAvailable: utils.Bool(d.Get("available").(bool)),
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating authentication context class reference %q: %+v", d.Id(), err)
+	}
+
+	return authenticationContextClassReferenceResourceRead(d, meta)
+}
+
+func authenticationContextClassReferenceResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationContextClassReferencesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	reference, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Authentication context class reference %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving authentication context class reference %q: %+v", d.Id(), err)
+	}
+
+	d.Set("class_reference_id", d.Id())
+	d.Set("description", reference.Description)
+	d.Set("display_name", reference.DisplayName)
+	d.Set("available", reference.IsAvailable)
+
+	return nil
+}
+
+func authenticationContextClassReferenceResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationContextClassReferencesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting authentication context class reference %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
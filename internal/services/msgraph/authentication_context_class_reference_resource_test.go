@@ -0,0 +1,107 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAuthenticationContextClassReference_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_context_class_reference", "test")
+
+	// not Parallel, as the c1-c25 namespace is shared across the tenant
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAuthenticationContextClassReferenceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthenticationContextClassReference_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthenticationContextClassReferenceExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "class_reference_id", "c25"),
+					resource.TestCheckResourceAttr(data.ResourceName, "available", "true"),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccAuthenticationContextClassReference_update(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthenticationContextClassReferenceExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "available", "false"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAuthenticationContextClassReferenceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AuthenticationContextClassReferencesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Authentication context class reference %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving authentication context class reference %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAuthenticationContextClassReferenceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_authentication_context_class_reference" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AuthenticationContextClassReferencesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving authentication context class reference %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Authentication context class reference %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAuthenticationContextClassReference_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_authentication_context_class_reference" "test" {
+  class_reference_id = "c25"
+  display_name       = "acctestACCR-%[1]d"
+  description        = "Require sensitive data access approval"
+}
+`, ri)
+}
+
+func testAccAuthenticationContextClassReference_update(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_authentication_context_class_reference" "test" {
+  class_reference_id = "c25"
+  display_name       = "acctestACCR-%[1]d-updated"
+  description        = "Require sensitive data access approval"
+  available          = false
+}
+`, ri)
+}
@@ -0,0 +1,219 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+// authenticationMethodsPolicyId is the fixed ID of the tenant-wide authentication
+// methods policy singleton.
+const authenticationMethodsPolicyId = "authenticationMethodsPolicy"
+
+func authenticationMethodsPolicySettingsResource() *schema.Resource {
+	return &schema.Resource{
+		Create: authenticationMethodsPolicySettingsResourceCreateUpdate,
+		Read:   authenticationMethodsPolicySettingsResourceRead,
+		Update: authenticationMethodsPolicySettingsResourceCreateUpdate,
+		Delete: authenticationMethodsPolicySettingsResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != authenticationMethodsPolicyId {
+				return fmt.Errorf("specified ID (%q) must be %q", id, authenticationMethodsPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"registration_campaign": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "default",
+							ValidateFunc: validation.StringInSlice([]string{
+								"default",
+								"disabled",
+								"enabled",
+							}, false),
+						},
+
+						"snooze_duration_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 14),
+						},
+					},
+				},
+			},
+
+			"report_suspicious_activity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "default",
+							ValidateFunc: validation.StringInSlice([]string{
+								"default",
+								"disabled",
+								"enabled",
+							}, false),
+						},
+
+						"voice_reporting_code": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 9),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func authenticationMethodsPolicySettingsResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationMethodsPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AuthenticationMethodsPolicy{
+		RegistrationEnforcement: &graph.RegistrationEnforcement{
+			AuthenticationMethodsRegistrationCampaign: expandRegistrationCampaign(d.Get("registration_campaign").([]interface{})),
+		},
+		ReportSuspiciousActivitySettings: expandReportSuspiciousActivitySettings(d.Get("report_suspicious_activity").([]interface{})),
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return fmt.Errorf("updating Authentication Methods Policy settings: %+v", err)
+	}
+
+	d.SetId(authenticationMethodsPolicyId)
+
+	return authenticationMethodsPolicySettingsResourceRead(d, meta)
+}
+
+func authenticationMethodsPolicySettingsResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationMethodsPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, _, err := client.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving Authentication Methods Policy: %+v", err)
+	}
+
+	campaign := make([]map[string]interface{}, 0)
+	if policy.RegistrationEnforcement != nil && policy.RegistrationEnforcement.AuthenticationMethodsRegistrationCampaign != nil {
+		c := policy.RegistrationEnforcement.AuthenticationMethodsRegistrationCampaign
+
+		state := ""
+		if c.State != nil {
+			state = *c.State
+		}
+
+		snoozeDuration := 0
+		if c.SnoozeDurationInDays != nil {
+			snoozeDuration = int(*c.SnoozeDurationInDays)
+		}
+
+		campaign = append(campaign, map[string]interface{}{
+			"state":                   state,
+			"snooze_duration_in_days": snoozeDuration,
+		})
+	}
+	d.Set("registration_campaign", campaign)
+
+	suspiciousActivity := make([]map[string]interface{}, 0)
+	if policy.ReportSuspiciousActivitySettings != nil {
+		s := policy.ReportSuspiciousActivitySettings
+
+		state := ""
+		if s.State != nil {
+			state = *s.State
+		}
+
+		voiceReportingCode := 0
+		if s.VoiceReportingCode != nil {
+			voiceReportingCode = int(*s.VoiceReportingCode)
+		}
+
+		suspiciousActivity = append(suspiciousActivity, map[string]interface{}{
+			"state":                state,
+			"voice_reporting_code": voiceReportingCode,
+		})
+	}
+	d.Set("report_suspicious_activity", suspiciousActivity)
+
+	return nil
+}
+
+// authenticationMethodsPolicySettingsResourceDelete resets the managed settings to their
+// defaults, since the underlying policy is a tenant singleton which cannot be deleted.
+func authenticationMethodsPolicySettingsResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationMethodsPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AuthenticationMethodsPolicy{
+		RegistrationEnforcement: &graph.RegistrationEnforcement{
+			AuthenticationMethodsRegistrationCampaign: &graph.AuthenticationMethodsRegistrationCampaign{
+				SnoozeDurationInDays: utils.Int32(1),
+				State:                utils.String("default"),
+			},
+		},
+		ReportSuspiciousActivitySettings: &graph.ReportSuspiciousActivitySettings{
+			State:              utils.String("default"),
+			VoiceReportingCode: utils.Int32(0),
+		},
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return fmt.Errorf("resetting Authentication Methods Policy settings: %+v", err)
+	}
+
+	return nil
+}
+
+func expandRegistrationCampaign(in []interface{}) *graph.AuthenticationMethodsRegistrationCampaign {
+	result := graph.AuthenticationMethodsRegistrationCampaign{
+		SnoozeDurationInDays: utils.Int32(1),
+		State:                utils.String("default"),
+	}
+
+	if len(in) > 0 && in[0] != nil {
+		campaign := in[0].(map[string]interface{})
+		result.SnoozeDurationInDays = utils.Int32(int32(campaign["snooze_duration_in_days"].(int)))
+		result.State = utils.String(campaign["state"].(string))
+	}
+
+	return &result
+}
+
+func expandReportSuspiciousActivitySettings(in []interface{}) *graph.ReportSuspiciousActivitySettings {
+	result := graph.ReportSuspiciousActivitySettings{
+		State:              utils.String("default"),
+		VoiceReportingCode: utils.Int32(0),
+	}
+
+	if len(in) > 0 && in[0] != nil {
+		settings := in[0].(map[string]interface{})
+		result.State = utils.String(settings["state"].(string))
+		result.VoiceReportingCode = utils.Int32(int32(settings["voice_reporting_code"].(int)))
+	}
+
+	return &result
+}
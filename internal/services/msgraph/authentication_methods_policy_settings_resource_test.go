@@ -0,0 +1,94 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAuthenticationMethodsPolicySettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_methods_policy_settings", "test")
+
+	// not Parallel, as the authentication methods policy is a tenant singleton
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAuthenticationMethodsPolicySettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthenticationMethodsPolicySettings_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthenticationMethodsPolicySettingsExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "registration_campaign.0.state", "enabled"),
+					resource.TestCheckResourceAttr(data.ResourceName, "registration_campaign.0.snooze_duration_in_days", "3"),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccAuthenticationMethodsPolicySettings_complete(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthenticationMethodsPolicySettingsExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "report_suspicious_activity.0.state", "enabled"),
+					resource.TestCheckResourceAttr(data.ResourceName, "report_suspicious_activity.0.voice_reporting_code", "5"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAuthenticationMethodsPolicySettingsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AuthenticationMethodsPolicyClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, _, err := client.Get(ctx); err != nil {
+			return fmt.Errorf("retrieving Authentication Methods Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAuthenticationMethodsPolicySettingsDestroy(s *terraform.State) error {
+	// the underlying policy is a tenant singleton which cannot be deleted, so there
+	// is nothing to verify here
+	return nil
+}
+
+func testAccAuthenticationMethodsPolicySettings_basic() string {
+	return `
+resource "azuread_authentication_methods_policy_settings" "test" {
+  registration_campaign {
+    state                   = "enabled"
+    snooze_duration_in_days = 3
+  }
+}
+`
+}
+
+func testAccAuthenticationMethodsPolicySettings_complete() string {
+	return `
+resource "azuread_authentication_methods_policy_settings" "test" {
+  registration_campaign {
+    state                   = "enabled"
+    snooze_duration_in_days = 3
+  }
+
+  report_suspicious_activity {
+    state                = "enabled"
+    voice_reporting_code = 5
+  }
+}
+`
+}
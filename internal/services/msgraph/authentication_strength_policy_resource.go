@@ -0,0 +1,142 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func authenticationStrengthPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: authenticationStrengthPolicyResourceCreate,
+		Read:   authenticationStrengthPolicyResourceRead,
+		Update: authenticationStrengthPolicyResourceUpdate,
+		Delete: authenticationStrengthPolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"allowed_combinations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func authenticationStrengthPolicyResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationStrengthPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	properties := graph.AuthenticationStrengthPolicy{
+		DisplayName:         utils.String(displayName),
+		Description:         utils.String(d.Get("description").(string)),
+		AllowedCombinations: tf.ExpandStringSlicePtr(d.Get("allowed_combinations").(*schema.Set).List()),
+	}
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Authentication Strength Policy %q: %+v", displayName, err)
+	}
+	if policy.ID == nil || *policy.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Authentication Strength Policy %q", displayName)
+	}
+
+	d.SetId(*policy.ID)
+
+	return authenticationStrengthPolicyResourceRead(d, meta)
+}
+
+func authenticationStrengthPolicyResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationStrengthPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if d.HasChange("display_name") || d.HasChange("description") {
+		properties := graph.AuthenticationStrengthPolicy{
+			DisplayName: utils.String(d.Get("display_name").(string)),
+			Description: utils.String(d.Get("description").(string)),
+		}
+
+		if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+			return fmt.Errorf("updating Authentication Strength Policy with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("allowed_combinations") {
+		allowedCombinations := *tf.ExpandStringSlicePtr(d.Get("allowed_combinations").(*schema.Set).List())
+
+		if _, err := client.UpdateAllowedCombinations(ctx, d.Id(), allowedCombinations); err != nil {
+			return fmt.Errorf("updating allowed combinations for Authentication Strength Policy with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return authenticationStrengthPolicyResourceRead(d, meta)
+}
+
+func authenticationStrengthPolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationStrengthPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Authentication Strength Policy with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Authentication Strength Policy with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("display_name", policy.DisplayName)
+	d.Set("description", policy.Description)
+	d.Set("allowed_combinations", tf.FlattenStringSlicePtr(policy.AllowedCombinations))
+
+	return nil
+}
+
+func authenticationStrengthPolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthenticationStrengthPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Authentication Strength Policy with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
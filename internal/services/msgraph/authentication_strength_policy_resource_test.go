@@ -0,0 +1,129 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAuthenticationStrengthPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_strength_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAuthenticationStrengthPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthenticationStrengthPolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthenticationStrengthPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestASP-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "allowed_combinations.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAuthenticationStrengthPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_strength_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAuthenticationStrengthPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthenticationStrengthPolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthenticationStrengthPolicyExists(data.ResourceName),
+				),
+			},
+			{
+				Config: testAccAuthenticationStrengthPolicy_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthenticationStrengthPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "description", "Acceptance test policy"),
+					resource.TestCheckResourceAttr(data.ResourceName, "allowed_combinations.#", "2"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAuthenticationStrengthPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AuthenticationStrengthPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Authentication Strength Policy %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Authentication Strength Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAuthenticationStrengthPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_authentication_strength_policy" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AuthenticationStrengthPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		policy, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Authentication Strength Policy still exists:\n%#v", policy)
+	}
+
+	return nil
+}
+
+func testAccAuthenticationStrengthPolicy_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_authentication_strength_policy" "test" {
+  display_name         = "acctestASP-%d"
+  allowed_combinations = ["fido2"]
+}
+`, id)
+}
+
+func testAccAuthenticationStrengthPolicy_complete(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_authentication_strength_policy" "test" {
+  display_name = "acctestASP-%d"
+  description  = "Acceptance test policy"
+
+  allowed_combinations = [
+    "fido2",
+    "x509CertificateMultiFactor",
+  ]
+}
+`, id)
+}
@@ -0,0 +1,256 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+// authorizationPolicyId is the fixed ID of the tenant-wide authorization policy singleton.
+const authorizationPolicyId = "authorizationPolicy"
+
+// guestUserRoleIdGuest is the built-in directory role conferring full guest user
+// permissions, which is the service default for guestUserRoleId.
+const guestUserRoleIdGuest = "10dae51f-b6af-4016-8d66-8c2a99b929b3"
+
+func authorizationPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: authorizationPolicyResourceCreateUpdate,
+		Read:   authorizationPolicyResourceRead,
+		Update: authorizationPolicyResourceCreateUpdate,
+		Delete: authorizationPolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != authorizationPolicyId {
+				return fmt.Errorf("specified ID (%q) must be %q", id, authorizationPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"allow_invites_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "everyone",
+				ValidateFunc: validation.StringInSlice([]string{
+					"adminsAndGuestInviters",
+					"adminsGuestInvitersAndAllMembers",
+					"everyone",
+					"none",
+				}, false),
+			},
+
+			"allowed_to_sign_up_email_based_subscriptions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allowed_to_use_sspr": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allow_email_verified_users_to_join_organization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"block_msol_powershell": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"guest_user_role_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      guestUserRoleIdGuest,
+				ValidateFunc: validate.UUID,
+			},
+
+			"default_user_role_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_to_create_apps": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"allowed_to_create_security_groups": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"allowed_to_create_tenants": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"allowed_to_read_other_users": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"permission_grant_policies_assigned": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func authorizationPolicyResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthorizationPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AuthorizationPolicy{
+		AllowEmailVerifiedUsersToJoinOrganization: utils.Bool(d.Get("allow_email_verified_users_to_join_organization").(bool)),
+		AllowInvitesFrom:                       utils.String(d.Get("allow_invites_from").(string)),
+		AllowedToSignUpEmailBasedSubscriptions: utils.Bool(d.Get("allowed_to_sign_up_email_based_subscriptions").(bool)),
+		AllowedToUseSSPR:                       utils.Bool(d.Get("allowed_to_use_sspr").(bool)),
+		BlockMsolPowerShell:                    utils.Bool(d.Get("block_msol_powershell").(bool)),
+		DefaultUserRolePermissions:             expandDefaultUserRolePermissions(d.Get("default_user_role_permissions").([]interface{})),
+		GuestUserRoleId:                        utils.String(d.Get("guest_user_role_id").(string)),
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return fmt.Errorf("updating Authorization Policy: %+v", err)
+	}
+
+	d.SetId(authorizationPolicyId)
+
+	return authorizationPolicyResourceRead(d, meta)
+}
+
+func authorizationPolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthorizationPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, _, err := client.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving Authorization Policy: %+v", err)
+	}
+
+	d.Set("allow_invites_from", policy.AllowInvitesFrom)
+	d.Set("allowed_to_sign_up_email_based_subscriptions", policy.AllowedToSignUpEmailBasedSubscriptions)
+	d.Set("allowed_to_use_sspr", policy.AllowedToUseSSPR)
+	d.Set("allow_email_verified_users_to_join_organization", policy.AllowEmailVerifiedUsersToJoinOrganization)
+	d.Set("block_msol_powershell", policy.BlockMsolPowerShell)
+	d.Set("guest_user_role_id", policy.GuestUserRoleId)
+
+	permissions := make([]map[string]interface{}, 0)
+	if p := policy.DefaultUserRolePermissions; p != nil {
+		allowedToCreateApps := false
+		if p.AllowedToCreateApps != nil {
+			allowedToCreateApps = *p.AllowedToCreateApps
+		}
+
+		allowedToCreateSecurityGroups := false
+		if p.AllowedToCreateSecurityGroups != nil {
+			allowedToCreateSecurityGroups = *p.AllowedToCreateSecurityGroups
+		}
+
+		allowedToCreateTenants := false
+		if p.AllowedToCreateTenants != nil {
+			allowedToCreateTenants = *p.AllowedToCreateTenants
+		}
+
+		allowedToReadOtherUsers := false
+		if p.AllowedToReadOtherUsers != nil {
+			allowedToReadOtherUsers = *p.AllowedToReadOtherUsers
+		}
+
+		grantPolicies := make([]string, 0)
+		if p.PermissionGrantPoliciesAssigned != nil {
+			grantPolicies = *p.PermissionGrantPoliciesAssigned
+		}
+
+		permissions = append(permissions, map[string]interface{}{
+			"allowed_to_create_apps":             allowedToCreateApps,
+			"allowed_to_create_security_groups":  allowedToCreateSecurityGroups,
+			"allowed_to_create_tenants":          allowedToCreateTenants,
+			"allowed_to_read_other_users":        allowedToReadOtherUsers,
+			"permission_grant_policies_assigned": grantPolicies,
+		})
+	}
+	d.Set("default_user_role_permissions", permissions)
+
+	return nil
+}
+
+// authorizationPolicyResourceDelete resets the policy to its documented defaults, since
+// the underlying policy is a tenant singleton which cannot be deleted.
+func authorizationPolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AuthorizationPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.AuthorizationPolicy{
+		AllowEmailVerifiedUsersToJoinOrganization: utils.Bool(true),
+		AllowInvitesFrom:                       utils.String("everyone"),
+		AllowedToSignUpEmailBasedSubscriptions: utils.Bool(true),
+		AllowedToUseSSPR:                       utils.Bool(true),
+		BlockMsolPowerShell:                    utils.Bool(false),
+		DefaultUserRolePermissions: &graph.DefaultUserRolePermissions{
+			AllowedToCreateApps:             utils.Bool(true),
+			AllowedToCreateSecurityGroups:   utils.Bool(true),
+			AllowedToCreateTenants:          utils.Bool(true),
+			AllowedToReadOtherUsers:         utils.Bool(true),
+			PermissionGrantPoliciesAssigned: &[]string{"ManagePermissionGrantsForSelf.microsoft-user-default-legacy"},
+		},
+		GuestUserRoleId: utils.String(guestUserRoleIdGuest),
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return fmt.Errorf("resetting Authorization Policy: %+v", err)
+	}
+
+	return nil
+}
+
+func expandDefaultUserRolePermissions(in []interface{}) *graph.DefaultUserRolePermissions {
+	result := graph.DefaultUserRolePermissions{
+		AllowedToCreateApps:           utils.Bool(true),
+		AllowedToCreateSecurityGroups: utils.Bool(true),
+		AllowedToCreateTenants:        utils.Bool(true),
+		AllowedToReadOtherUsers:       utils.Bool(true),
+	}
+
+	if len(in) > 0 && in[0] != nil {
+		permissions := in[0].(map[string]interface{})
+		result.AllowedToCreateApps = utils.Bool(permissions["allowed_to_create_apps"].(bool))
+		result.AllowedToCreateSecurityGroups = utils.Bool(permissions["allowed_to_create_security_groups"].(bool))
+		result.AllowedToCreateTenants = utils.Bool(permissions["allowed_to_create_tenants"].(bool))
+		result.AllowedToReadOtherUsers = utils.Bool(permissions["allowed_to_read_other_users"].(bool))
+
+		if grantPolicies := permissions["permission_grant_policies_assigned"].([]interface{}); len(grantPolicies) > 0 {
+			result.PermissionGrantPoliciesAssigned = tf.ExpandStringSlicePtr(grantPolicies)
+		}
+	}
+
+	return &result
+}
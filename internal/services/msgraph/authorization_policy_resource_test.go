@@ -0,0 +1,71 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccAuthorizationPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authorization_policy", "test")
+
+	// not Parallel, as the authorization policy is a tenant singleton
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAuthorizationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationPolicy_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthorizationPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "allow_invites_from", "adminsAndGuestInviters"),
+					resource.TestCheckResourceAttr(data.ResourceName, "default_user_role_permissions.0.allowed_to_create_tenants", "false"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAuthorizationPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[name]; !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AuthorizationPolicyClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, _, err := client.Get(ctx); err != nil {
+			return fmt.Errorf("retrieving Authorization Policy: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAuthorizationPolicyDestroy(s *terraform.State) error {
+	// the authorization policy cannot be deleted, it is reset to defaults on destroy,
+	// so there is nothing to verify here
+	return nil
+}
+
+func testAccAuthorizationPolicy_basic() string {
+	return `
+resource "azuread_authorization_policy" "test" {
+  allow_invites_from  = "adminsAndGuestInviters"
+  allowed_to_use_sspr = false
+
+  default_user_role_permissions {
+    allowed_to_create_apps    = false
+    allowed_to_create_tenants = false
+  }
+}
+`
+}
@@ -0,0 +1,152 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func b2cIdentityProviderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: b2cIdentityProviderResourceCreate,
+		Read:   b2cIdentityProviderResourceRead,
+		Update: b2cIdentityProviderResourceUpdate,
+		Delete: b2cIdentityProviderResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the ID of the identity provider")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Amazon",
+					"Facebook",
+					"GitHub",
+					"Google",
+					"LinkedIn",
+					"Microsoft",
+					"OpenIDConnect",
+					"QQ",
+					"Twitter",
+					"WeChat",
+					"Weibo",
+				}, false),
+			},
+
+			"client_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"client_secret": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func b2cIdentityProviderResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	identityProvider, _, err := client.Create(ctx, graph.IdentityProvider{
+		Name:         utils.String(displayName),
+		Type:         utils.String(d.Get("type").(string)),
+		ClientId:     utils.String(d.Get("client_id").(string)),
+		ClientSecret: utils.String(d.Get("client_secret").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("creating B2C Identity Provider %q: %+v", displayName, err)
+	}
+	if identityProvider == nil || identityProvider.ID == nil || *identityProvider.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for B2C Identity Provider %q", displayName)
+	}
+
+	d.SetId(*identityProvider.ID)
+
+	return b2cIdentityProviderResourceRead(d, meta)
+}
+
+func b2cIdentityProviderResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.IdentityProvider{
+		Name:     utils.String(d.Get("display_name").(string)),
+		ClientId: utils.String(d.Get("client_id").(string)),
+	}
+
+	if d.HasChange("client_secret") {
+		properties.ClientSecret = utils.String(d.Get("client_secret").(string))
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating B2C Identity Provider %q: %+v", d.Id(), err)
+	}
+
+	return b2cIdentityProviderResourceRead(d, meta)
+}
+
+func b2cIdentityProviderResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	identityProvider, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] B2C Identity Provider %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving B2C Identity Provider %q: %+v", d.Id(), err)
+	}
+
+	d.Set("display_name", identityProvider.Name)
+	d.Set("type", identityProvider.Type)
+	d.Set("client_id", identityProvider.ClientId)
+
+	// the API masks the client secret, so the configured value is kept in state
+
+	return nil
+}
+
+func b2cIdentityProviderResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting B2C Identity Provider %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
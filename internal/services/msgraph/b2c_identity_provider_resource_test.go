@@ -0,0 +1,87 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccB2CIdentityProvider_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_b2c_identity_provider", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckB2CIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccB2CIdentityProvider_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckB2CIdentityProviderExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "type", "Google"),
+				),
+			},
+			data.ImportStep("client_secret"),
+		},
+	})
+}
+
+func testCheckB2CIdentityProviderExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.IdentityProvidersClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("B2C Identity Provider %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving B2C Identity Provider %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckB2CIdentityProviderDestroy(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_b2c_identity_provider" {
+			continue
+		}
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving B2C Identity Provider %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("B2C Identity Provider %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccB2CIdentityProvider_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_b2c_identity_provider" "test" {
+  display_name  = "acctestIdP-%[1]d"
+  type          = "Google"
+  client_id     = "0000000000000.apps.googleusercontent.com"
+  client_secret = "acctest-not-a-real-secret"
+}
+`, id)
+}
@@ -0,0 +1,301 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func b2cUserFlowResource() *schema.Resource {
+	return &schema.Resource{
+		Create: b2cUserFlowResourceCreate,
+		Read:   b2cUserFlowResourceRead,
+		Update: b2cUserFlowResourceUpdate,
+		Delete: b2cUserFlowResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the ID of the user flow")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"user_flow_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"passwordReset",
+					"profileUpdate",
+					"signIn",
+					"signUp",
+					"signUpOrSignIn",
+				}, false),
+			},
+
+			"user_flow_type_version": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"identity_provider_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"user_attribute": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"is_optional": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"requires_verification": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func b2cUserFlowResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.B2CUserFlowsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	name := d.Get("name").(string)
+
+	properties := graph.B2CUserFlow{
+		ID:           utils.String(name),
+		UserFlowType: utils.String(d.Get("user_flow_type").(string)),
+	}
+
+	if v, ok := d.GetOk("user_flow_type_version"); ok {
+		version := v.(float64)
+		properties.UserFlowTypeVersion = &version
+	}
+
+	userFlow, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating B2C User Flow %q: %+v", name, err)
+	}
+	if userFlow == nil || userFlow.ID == nil || *userFlow.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for B2C User Flow %q", name)
+	}
+
+	d.SetId(*userFlow.ID)
+
+	for _, providerId := range d.Get("identity_provider_ids").(*schema.Set).List() {
+		if _, err := client.AddIdentityProvider(ctx, d.Id(), providerId.(string)); err != nil {
+			return fmt.Errorf("adding identity provider %q to B2C User Flow %q: %+v", providerId, d.Id(), err)
+		}
+	}
+
+	for _, raw := range d.Get("user_attribute").(*schema.Set).List() {
+		attribute := raw.(map[string]interface{})
+		assignment := graph.B2CUserFlowAttributeAssignment{
+			IsOptional:           utils.Bool(attribute["is_optional"].(bool)),
+			RequiresVerification: utils.Bool(attribute["requires_verification"].(bool)),
+		}
+		if _, err := client.AddAttributeAssignment(ctx, d.Id(), attribute["id"].(string), assignment); err != nil {
+			return fmt.Errorf("adding user attribute %q to B2C User Flow %q: %+v", attribute["id"], d.Id(), err)
+		}
+	}
+
+	return b2cUserFlowResourceRead(d, meta)
+}
+
+func b2cUserFlowResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.B2CUserFlowsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if d.HasChange("identity_provider_ids") {
+		o, n := d.GetChange("identity_provider_ids")
+		oldProviders, newProviders := o.(*schema.Set), n.(*schema.Set)
+
+		for _, providerId := range oldProviders.Difference(newProviders).List() {
+			if _, err := client.RemoveIdentityProvider(ctx, d.Id(), providerId.(string)); err != nil {
+				return fmt.Errorf("removing identity provider %q from B2C User Flow %q: %+v", providerId, d.Id(), err)
+			}
+		}
+
+		for _, providerId := range newProviders.Difference(oldProviders).List() {
+			if _, err := client.AddIdentityProvider(ctx, d.Id(), providerId.(string)); err != nil {
+				return fmt.Errorf("adding identity provider %q to B2C User Flow %q: %+v", providerId, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("user_attribute") {
+		assignments, _, err := client.ListAttributeAssignments(ctx, d.Id())
+		if err != nil {
+			return fmt.Errorf("listing user attribute assignments for B2C User Flow %q: %+v", d.Id(), err)
+		}
+
+		// map the assignment IDs by their underlying attribute IDs, since the
+		// assignment endpoints are addressed by assignment ID
+		assignmentIds := make(map[string]string)
+		for _, assignment := range assignments {
+			if assignment.ID != nil && assignment.UserAttribute != nil && assignment.UserAttribute.ID != nil {
+				assignmentIds[*assignment.UserAttribute.ID] = *assignment.ID
+			}
+		}
+
+		o, n := d.GetChange("user_attribute")
+		oldAttributes := b2cUserFlowAttributesByID(o.(*schema.Set))
+		newAttributes := b2cUserFlowAttributesByID(n.(*schema.Set))
+
+		for attributeId := range oldAttributes {
+			if _, ok := newAttributes[attributeId]; !ok {
+				if _, err := client.RemoveAttributeAssignment(ctx, d.Id(), assignmentIds[attributeId]); err != nil {
+					return fmt.Errorf("removing user attribute %q from B2C User Flow %q: %+v", attributeId, d.Id(), err)
+				}
+			}
+		}
+
+		for attributeId, attribute := range newAttributes {
+			assignment := graph.B2CUserFlowAttributeAssignment{
+				IsOptional:           utils.Bool(attribute["is_optional"].(bool)),
+				RequiresVerification: utils.Bool(attribute["requires_verification"].(bool)),
+			}
+
+			if assignmentId, ok := assignmentIds[attributeId]; ok {
+				if _, err := client.UpdateAttributeAssignment(ctx, d.Id(), assignmentId, assignment); err != nil {
+					return fmt.Errorf("updating user attribute %q for B2C User Flow %q: %+v", attributeId, d.Id(), err)
+				}
+			} else {
+				if _, err := client.AddAttributeAssignment(ctx, d.Id(), attributeId, assignment); err != nil {
+					return fmt.Errorf("adding user attribute %q to B2C User Flow %q: %+v", attributeId, d.Id(), err)
+				}
+			}
+		}
+	}
+
+	return b2cUserFlowResourceRead(d, meta)
+}
+
+func b2cUserFlowResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.B2CUserFlowsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	userFlow, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] B2C User Flow %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving B2C User Flow %q: %+v", d.Id(), err)
+	}
+
+	name := ""
+	if userFlow.ID != nil {
+		// the API prefixes the configured name to form the user flow ID
+		name = strings.TrimPrefix(*userFlow.ID, "B2C_1_")
+	}
+	d.Set("name", name)
+	d.Set("user_flow_type", userFlow.UserFlowType)
+	d.Set("user_flow_type_version", userFlow.UserFlowTypeVersion)
+
+	providerIds, _, err := client.ListIdentityProviders(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("listing identity providers for B2C User Flow %q: %+v", d.Id(), err)
+	}
+	d.Set("identity_provider_ids", providerIds)
+
+	assignments, _, err := client.ListAttributeAssignments(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("listing user attribute assignments for B2C User Flow %q: %+v", d.Id(), err)
+	}
+
+	// user flows include built-in attribute assignments depending on the flow type,
+	// so only the attributes present in configuration are tracked
+	configured := b2cUserFlowAttributesByID(d.Get("user_attribute").(*schema.Set))
+	userAttributes := make([]interface{}, 0)
+	for _, assignment := range assignments {
+		if assignment.UserAttribute == nil || assignment.UserAttribute.ID == nil {
+			continue
+		}
+		if _, ok := configured[*assignment.UserAttribute.ID]; !ok {
+			continue
+		}
+
+		isOptional := false
+		if assignment.IsOptional != nil {
+			isOptional = *assignment.IsOptional
+		}
+
+		requiresVerification := false
+		if assignment.RequiresVerification != nil {
+			requiresVerification = *assignment.RequiresVerification
+		}
+
+		userAttributes = append(userAttributes, map[string]interface{}{
+			"id":                    *assignment.UserAttribute.ID,
+			"is_optional":           isOptional,
+			"requires_verification": requiresVerification,
+		})
+	}
+	d.Set("user_attribute", userAttributes)
+
+	return nil
+}
+
+func b2cUserFlowResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.B2CUserFlowsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting B2C User Flow %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func b2cUserFlowAttributesByID(attributes *schema.Set) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	for _, raw := range attributes.List() {
+		attribute := raw.(map[string]interface{})
+		result[attribute["id"].(string)] = attribute
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccB2CUserFlow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_b2c_user_flow", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckB2CUserFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccB2CUserFlow_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckB2CUserFlowExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "user_flow_type", "signUpOrSignIn"),
+					resource.TestCheckResourceAttr(data.ResourceName, "user_attribute.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckB2CUserFlowExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.B2CUserFlowsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("B2C User Flow %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving B2C User Flow %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckB2CUserFlowDestroy(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.B2CUserFlowsClient
+	ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_b2c_user_flow" {
+			continue
+		}
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving B2C User Flow %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("B2C User Flow %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccB2CUserFlow_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_b2c_user_flow" "test" {
+  name           = "acctestFlow%[1]d"
+  user_flow_type = "signUpOrSignIn"
+
+  user_attribute {
+    id          = "city"
+    is_optional = true
+  }
+}
+`, id)
+}
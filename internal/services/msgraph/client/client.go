@@ -0,0 +1,262 @@
+package client
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+type Client struct {
+	AccessPackageAssignmentPoliciesClient      *graph.AccessPackageAssignmentPoliciesClient
+	AccessPackageAssignmentRequestsClient      *graph.AccessPackageAssignmentRequestsClient
+	AccessPackageCatalogsClient                *graph.AccessPackageCatalogsClient
+	AccessPackageResourceRequestsClient        *graph.AccessPackageResourceRequestsClient
+	AccessPackagesClient                       *graph.AccessPackagesClient
+	AccessReviewScheduleDefinitionsClient      *graph.AccessReviewScheduleDefinitionsClient
+	AdminConsentRequestPolicyClient            *graph.AdminConsentRequestPolicyClient
+	AdministrativeUnitsClient                  *graph.AdministrativeUnitsClient
+	ApplicationsClient                         *graph.ApplicationsClient
+	ApplicationTemplatesClient                 *graph.ApplicationTemplatesClient
+	AppManagementPoliciesClient                *graph.AppManagementPoliciesClient
+	AppRoleAssignmentsClient                   *graph.AppRoleAssignmentsClient
+	AuthenticationContextClassReferencesClient *graph.AuthenticationContextClassReferencesClient
+	AuthenticationMethodsPolicyClient          *graph.AuthenticationMethodsPolicyClient
+	AuthenticationStrengthPoliciesClient       *graph.AuthenticationStrengthPoliciesClient
+	AuthorizationPolicyClient                  *graph.AuthorizationPolicyClient
+	B2CUserFlowsClient                         *graph.B2CUserFlowsClient
+	ConditionalAccessPoliciesClient            *graph.ConditionalAccessPoliciesClient
+	CrossTenantAccessPolicyClient              *graph.CrossTenantAccessPolicyClient
+	CustomSecurityAttributesClient             *graph.CustomSecurityAttributesClient
+	DeletedItemsClient                         *graph.DeletedItemsClient
+	DevicesClient                              *graph.DevicesClient
+	DirectoryRolesClient                       *graph.DirectoryRolesClient
+	DomainsClient                              *graph.DomainsClient
+	DirectoryRoleTemplatesClient               *graph.DirectoryRoleTemplatesClient
+	DirectorySettingsClient                    *graph.DirectorySettingsClient
+	EntitlementRoleAssignmentsClient           *graph.EntitlementRoleAssignmentsClient
+	GroupAssignmentScheduleRequestsClient      *graph.PrivilegedAccessGroupAssignmentScheduleRequestsClient
+	GroupEligibilityScheduleRequestsClient     *graph.PrivilegedAccessGroupEligibilityScheduleRequestsClient
+	GroupsClient                               *graph.GroupsClient
+	GroupLifecyclePoliciesClient               *graph.GroupLifecyclePoliciesClient
+	IdentityProvidersClient                    *graph.IdentityProvidersClient
+	InvitationsClient                          *graph.InvitationsClient
+	NamedLocationsClient                       *graph.NamedLocationsClient
+	OrganizationBrandingClient                 *graph.OrganizationBrandingClient
+	PermissionGrantPoliciesClient              *graph.PermissionGrantPoliciesClient
+	PolicyAssignmentsClient                    *graph.PolicyAssignmentsClient
+	RoleAssignmentsClient                      *graph.RoleAssignmentsClient
+	RoleDefinitionsClient                      *graph.RoleDefinitionsClient
+	RoleEligibilityScheduleRequestsClient      *graph.RoleEligibilityScheduleRequestsClient
+	SchemaExtensionsClient                     *graph.SchemaExtensionsClient
+	ServicePrincipalsClient                    *graph.ServicePrincipalsClient
+	SubscribedSkusClient                       *graph.SubscribedSkusClient
+	SynchronizationClient                      *graph.SynchronizationClient
+	TokenIssuancePoliciesClient                *graph.TokenIssuancePoliciesClient
+	UsersClient                                *graph.UsersClient
+}
+
+func BuildClient(o *services.ClientOptions, endpoint string, authorizer autorest.Authorizer) *Client {
+	adminConsentRequestPolicyClient := graph.NewAdminConsentRequestPolicyClient(endpoint, o.TenantID)
+	o.ConfigureClient(&adminConsentRequestPolicyClient.Client, authorizer)
+
+	administrativeUnitsClient := graph.NewAdministrativeUnitsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&administrativeUnitsClient.Client, authorizer)
+
+	applicationsClient := graph.NewApplicationsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&applicationsClient.Client, authorizer)
+
+	applicationTemplatesClient := graph.NewApplicationTemplatesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&applicationTemplatesClient.Client, authorizer)
+
+	appManagementPoliciesClient := graph.NewAppManagementPoliciesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&appManagementPoliciesClient.Client, authorizer)
+
+	appRoleAssignmentsClient := graph.NewAppRoleAssignmentsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&appRoleAssignmentsClient.Client, authorizer)
+
+	authenticationContextClassReferencesClient := graph.NewAuthenticationContextClassReferencesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&authenticationContextClassReferencesClient.Client, authorizer)
+
+	authenticationMethodsPolicyClient := graph.NewAuthenticationMethodsPolicyClient(endpoint, o.TenantID)
+	o.ConfigureClient(&authenticationMethodsPolicyClient.Client, authorizer)
+
+	authenticationStrengthPoliciesClient := graph.NewAuthenticationStrengthPoliciesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&authenticationStrengthPoliciesClient.Client, authorizer)
+
+	authorizationPolicyClient := graph.NewAuthorizationPolicyClient(endpoint, o.TenantID)
+	o.ConfigureClient(&authorizationPolicyClient.Client, authorizer)
+
+	b2cUserFlowsClient := graph.NewB2CUserFlowsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&b2cUserFlowsClient.Client, authorizer)
+
+	directoryRolesClient := graph.NewDirectoryRolesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&directoryRolesClient.Client, authorizer)
+
+	directoryRoleTemplatesClient := graph.NewDirectoryRoleTemplatesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&directoryRoleTemplatesClient.Client, authorizer)
+
+	conditionalAccessPoliciesClient := graph.NewConditionalAccessPoliciesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&conditionalAccessPoliciesClient.Client, authorizer)
+
+	crossTenantAccessPolicyClient := graph.NewCrossTenantAccessPolicyClient(endpoint, o.TenantID)
+	o.ConfigureClient(&crossTenantAccessPolicyClient.Client, authorizer)
+
+	customSecurityAttributesClient := graph.NewCustomSecurityAttributesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&customSecurityAttributesClient.Client, authorizer)
+
+	directorySettingsClient := graph.NewDirectorySettingsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&directorySettingsClient.Client, authorizer)
+
+	deletedItemsClient := graph.NewDeletedItemsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&deletedItemsClient.Client, authorizer)
+
+	devicesClient := graph.NewDevicesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&devicesClient.Client, authorizer)
+
+	domainsClient := graph.NewDomainsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&domainsClient.Client, authorizer)
+
+	entitlementRoleAssignmentsClient := graph.NewEntitlementRoleAssignmentsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&entitlementRoleAssignmentsClient.Client, authorizer)
+
+	groupAssignmentScheduleRequestsClient := graph.NewPrivilegedAccessGroupAssignmentScheduleRequestsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&groupAssignmentScheduleRequestsClient.Client, authorizer)
+
+	groupEligibilityScheduleRequestsClient := graph.NewPrivilegedAccessGroupEligibilityScheduleRequestsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&groupEligibilityScheduleRequestsClient.Client, authorizer)
+
+	groupsClient := graph.NewGroupsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&groupsClient.Client, authorizer)
+
+	groupLifecyclePoliciesClient := graph.NewGroupLifecyclePoliciesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&groupLifecyclePoliciesClient.Client, authorizer)
+
+	identityProvidersClient := graph.NewIdentityProvidersClient(endpoint, o.TenantID)
+	o.ConfigureClient(&identityProvidersClient.Client, authorizer)
+
+	invitationsClient := graph.NewInvitationsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&invitationsClient.Client, authorizer)
+
+	namedLocationsClient := graph.NewNamedLocationsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&namedLocationsClient.Client, authorizer)
+
+	organizationBrandingClient := graph.NewOrganizationBrandingClient(endpoint, o.TenantID)
+	o.ConfigureClient(&organizationBrandingClient.Client, authorizer)
+
+	permissionGrantPoliciesClient := graph.NewPermissionGrantPoliciesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&permissionGrantPoliciesClient.Client, authorizer)
+
+	policyAssignmentsClient := graph.NewPolicyAssignmentsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&policyAssignmentsClient.Client, authorizer)
+
+	roleAssignmentsClient := graph.NewRoleAssignmentsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&roleAssignmentsClient.Client, authorizer)
+
+	roleDefinitionsClient := graph.NewRoleDefinitionsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&roleDefinitionsClient.Client, authorizer)
+
+	roleEligibilityScheduleRequestsClient := graph.NewRoleEligibilityScheduleRequestsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&roleEligibilityScheduleRequestsClient.Client, authorizer)
+
+	accessPackagesClient := graph.NewAccessPackagesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&accessPackagesClient.Client, authorizer)
+
+	accessPackageAssignmentPoliciesClient := graph.NewAccessPackageAssignmentPoliciesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&accessPackageAssignmentPoliciesClient.Client, authorizer)
+
+	accessPackageAssignmentRequestsClient := graph.NewAccessPackageAssignmentRequestsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&accessPackageAssignmentRequestsClient.Client, authorizer)
+
+	accessPackageCatalogsClient := graph.NewAccessPackageCatalogsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&accessPackageCatalogsClient.Client, authorizer)
+
+	accessPackageResourceRequestsClient := graph.NewAccessPackageResourceRequestsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&accessPackageResourceRequestsClient.Client, authorizer)
+
+	accessReviewScheduleDefinitionsClient := graph.NewAccessReviewScheduleDefinitionsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&accessReviewScheduleDefinitionsClient.Client, authorizer)
+
+	schemaExtensionsClient := graph.NewSchemaExtensionsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&schemaExtensionsClient.Client, authorizer)
+
+	servicePrincipalsClient := graph.NewServicePrincipalsClient(endpoint, o.TenantID)
+	o.ConfigureClient(&servicePrincipalsClient.Client, authorizer)
+
+	subscribedSkusClient := graph.NewSubscribedSkusClient(endpoint, o.TenantID)
+	o.ConfigureClient(&subscribedSkusClient.Client, authorizer)
+
+	synchronizationClient := graph.NewSynchronizationClient(endpoint, o.TenantID)
+	o.ConfigureClient(&synchronizationClient.Client, authorizer)
+
+	tokenIssuancePoliciesClient := graph.NewTokenIssuancePoliciesClient(endpoint, o.TenantID)
+	o.ConfigureClient(&tokenIssuancePoliciesClient.Client, authorizer)
+
+	usersClient := graph.NewUsersClient(endpoint, o.TenantID)
+	o.ConfigureClient(&usersClient.Client, authorizer)
+
+	return &Client{
+		AccessPackageAssignmentPoliciesClient:      &accessPackageAssignmentPoliciesClient,
+		AccessPackageAssignmentRequestsClient:      &accessPackageAssignmentRequestsClient,
+		AccessPackageCatalogsClient:                &accessPackageCatalogsClient,
+		AccessPackageResourceRequestsClient:        &accessPackageResourceRequestsClient,
+		AccessPackagesClient:                       &accessPackagesClient,
+		AccessReviewScheduleDefinitionsClient:      &accessReviewScheduleDefinitionsClient,
+		AdminConsentRequestPolicyClient:            &adminConsentRequestPolicyClient,
+		AdministrativeUnitsClient:                  &administrativeUnitsClient,
+		ApplicationsClient:                         &applicationsClient,
+		ApplicationTemplatesClient:                 &applicationTemplatesClient,
+		AppManagementPoliciesClient:                &appManagementPoliciesClient,
+		AppRoleAssignmentsClient:                   &appRoleAssignmentsClient,
+		AuthenticationContextClassReferencesClient: &authenticationContextClassReferencesClient,
+		AuthenticationMethodsPolicyClient:          &authenticationMethodsPolicyClient,
+		AuthenticationStrengthPoliciesClient:       &authenticationStrengthPoliciesClient,
+		AuthorizationPolicyClient:                  &authorizationPolicyClient,
+		B2CUserFlowsClient:                         &b2cUserFlowsClient,
+		ConditionalAccessPoliciesClient:            &conditionalAccessPoliciesClient,
+		CrossTenantAccessPolicyClient:              &crossTenantAccessPolicyClient,
+		CustomSecurityAttributesClient:             &customSecurityAttributesClient,
+		DeletedItemsClient:                         &deletedItemsClient,
+		DevicesClient:                              &devicesClient,
+		DirectoryRolesClient:                       &directoryRolesClient,
+		DomainsClient:                              &domainsClient,
+		DirectoryRoleTemplatesClient:               &directoryRoleTemplatesClient,
+		DirectorySettingsClient:                    &directorySettingsClient,
+		EntitlementRoleAssignmentsClient:           &entitlementRoleAssignmentsClient,
+		GroupAssignmentScheduleRequestsClient:      &groupAssignmentScheduleRequestsClient,
+		GroupEligibilityScheduleRequestsClient:     &groupEligibilityScheduleRequestsClient,
+		GroupsClient:                               &groupsClient,
+		GroupLifecyclePoliciesClient:               &groupLifecyclePoliciesClient,
+		IdentityProvidersClient:                    &identityProvidersClient,
+		InvitationsClient:                          &invitationsClient,
+		NamedLocationsClient:                       &namedLocationsClient,
+		OrganizationBrandingClient:                 &organizationBrandingClient,
+		PermissionGrantPoliciesClient:              &permissionGrantPoliciesClient,
+		PolicyAssignmentsClient:                    &policyAssignmentsClient,
+		RoleAssignmentsClient:                      &roleAssignmentsClient,
+		RoleDefinitionsClient:                      &roleDefinitionsClient,
+		RoleEligibilityScheduleRequestsClient:      &roleEligibilityScheduleRequestsClient,
+		SchemaExtensionsClient:                     &schemaExtensionsClient,
+		ServicePrincipalsClient:                    &servicePrincipalsClient,
+		SubscribedSkusClient:                       &subscribedSkusClient,
+		SynchronizationClient:                      &synchronizationClient,
+		TokenIssuancePoliciesClient:                &tokenIssuancePoliciesClient,
+		UsersClient:                                &usersClient,
+	}
+}
+
+// Endpoint returns the Microsoft Graph endpoint for the given environment, since
+// go-autorest does not yet describe it in azure.Environment.
+func Endpoint(env azure.Environment) string {
+	switch env.Name {
+	case azure.ChinaCloud.Name:
+		return "https://microsoftgraph.chinacloudapi.cn/"
+	case azure.USGovernmentCloud.Name:
+		return "https://graph.microsoft.us/"
+	case azure.GermanCloud.Name:
+		return "https://graph.microsoft.de/"
+	default:
+		return "https://graph.microsoft.com/"
+	}
+}
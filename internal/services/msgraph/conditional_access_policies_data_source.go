@@ -0,0 +1,85 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func conditionalAccessPoliciesData() *schema.Resource {
+	return &schema.Resource{
+		Read: conditionalAccessPoliciesDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func conditionalAccessPoliciesDataRead(d *schema.ResourceData, meta interface{}) error {
+	tenantId := meta.(*clients.AadClient).TenantID
+	client := meta.(*clients.AadClient).MsGraph.ConditionalAccessPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	result, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Conditional Access Policies: %+v", err)
+	}
+
+	d.SetId("conditionalAccessPolicies-" + tenantId)
+
+	if err = d.Set("policies", flattenConditionalAccessPolicies(result)); err != nil {
+		return fmt.Errorf("setting `policies`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenConditionalAccessPolicies(input []graph.ConditionalAccessPolicy) []interface{} {
+	policies := make([]interface{}, 0)
+	for _, v := range input {
+		policy := map[string]interface{}{
+			"object_id":    "",
+			"display_name": "",
+			"state":        "",
+		}
+
+		if v.ID != nil {
+			policy["object_id"] = *v.ID
+		}
+		if v.DisplayName != nil {
+			policy["display_name"] = *v.DisplayName
+		}
+		if v.State != nil {
+			policy["state"] = *v.State
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
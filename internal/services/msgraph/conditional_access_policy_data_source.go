@@ -0,0 +1,73 @@
+package msgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func conditionalAccessPolicyData() *schema.Resource {
+	return &schema.Resource{
+		Read: conditionalAccessPolicyDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func conditionalAccessPolicyDataRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.ConditionalAccessPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	policies, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Conditional Access Policies: %+v", err)
+	}
+
+	var matches []string
+	var objectId, state string
+	for _, v := range policies {
+		if v.DisplayName != nil && strings.EqualFold(*v.DisplayName, displayName) && v.ID != nil {
+			matches = append(matches, *v.ID)
+			objectId = *v.ID
+			if v.State != nil {
+				state = *v.State
+			}
+		}
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("found no Conditional Access Policy with display name %q", displayName)
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("found multiple Conditional Access Policies with display name %q: %v", displayName, matches)
+	}
+
+	d.SetId(objectId)
+	d.Set("object_id", objectId)
+	d.Set("display_name", displayName)
+	d.Set("state", state)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccConditionalAccessPolicyDataSource_byDisplayName(t *testing.T) {
+	displayName := os.Getenv("ARM_TEST_CONDITIONAL_ACCESS_POLICY_DISPLAY_NAME")
+	if displayName == "" {
+		t.Skip("skipping as `ARM_TEST_CONDITIONAL_ACCESS_POLICY_DISPLAY_NAME` was not specified in the environment")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_conditional_access_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConditionalAccessPolicyDataSource_byDisplayName(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "state"),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", displayName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConditionalAccessPolicyDataSource_notFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_conditional_access_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConditionalAccessPolicyDataSource_byDisplayName(fmt.Sprintf("acctestCAP-nonexistent-%d", data.RandomInteger)),
+				ExpectError: regexp.MustCompile("found no Conditional Access Policy with display name"),
+			},
+		},
+	})
+}
+
+func TestAccConditionalAccessPolicyDataSource_ambiguousDisplayName(t *testing.T) {
+	// the MS Graph client is read-only for conditional access policies, so duplicate
+	// policies cannot be provisioned by the test and must be prepared in the tenant
+	displayName := os.Getenv("ARM_TEST_CONDITIONAL_ACCESS_POLICY_DUPLICATE_DISPLAY_NAME")
+	if displayName == "" {
+		t.Skip("skipping as `ARM_TEST_CONDITIONAL_ACCESS_POLICY_DUPLICATE_DISPLAY_NAME` was not specified in the environment")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConditionalAccessPolicyDataSource_byDisplayName(displayName),
+				ExpectError: regexp.MustCompile("found multiple Conditional Access Policies with display name"),
+			},
+		},
+	})
+}
+
+func testAccConditionalAccessPolicyDataSource_byDisplayName(displayName string) string {
+	return fmt.Sprintf(`
+data "azuread_conditional_access_policy" "test" {
+  display_name = "%s"
+}
+`, displayName)
+}
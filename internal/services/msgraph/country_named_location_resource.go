@@ -0,0 +1,168 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func countryNamedLocationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: countryNamedLocationResourceCreate,
+		Read:   countryNamedLocationResourceRead,
+		Update: countryNamedLocationResourceUpdate,
+		Delete: countryNamedLocationResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"countries_and_regions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"include_unknown_countries_and_regions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func countryNamedLocationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.NamedLocation{
+		ODataType:                         utils.String(graph.ODataTypeCountryNamedLocation),
+		DisplayName:                       utils.String(d.Get("display_name").(string)),
+		CountriesAndRegions:               expandCountriesAndRegions(d.Get("countries_and_regions").([]interface{})),
+		IncludeUnknownCountriesAndRegions: utils.Bool(d.Get("include_unknown_countries_and_regions").(bool)),
+	}
+
+	location, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Country Named Location %q: %+v", d.Get("display_name").(string), err)
+	}
+
+	if location.ID == nil || *location.ID == "" {
+		return fmt.Errorf("Country Named Location ID is empty or nil")
+	}
+
+	d.SetId(*location.ID)
+
+	return countryNamedLocationResourceRead(d, meta)
+}
+
+func countryNamedLocationResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.NamedLocation{
+		ODataType: utils.String(graph.ODataTypeCountryNamedLocation),
+	}
+
+	if d.HasChange("display_name") {
+		properties.DisplayName = utils.String(d.Get("display_name").(string))
+	}
+
+	if d.HasChange("countries_and_regions") {
+		properties.CountriesAndRegions = expandCountriesAndRegions(d.Get("countries_and_regions").([]interface{}))
+	}
+
+	if d.HasChange("include_unknown_countries_and_regions") {
+		properties.IncludeUnknownCountriesAndRegions = utils.Bool(d.Get("include_unknown_countries_and_regions").(bool))
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Country Named Location with ID %q: %+v", d.Id(), err)
+	}
+
+	return countryNamedLocationResourceRead(d, meta)
+}
+
+func countryNamedLocationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	location, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Country Named Location with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Country Named Location with ID %q: %+v", d.Id(), err)
+	}
+
+	if location.ODataType == nil || *location.ODataType != graph.ODataTypeCountryNamedLocation {
+		return fmt.Errorf("Named Location with ID %q is not a Country Named Location", d.Id())
+	}
+
+	d.Set("display_name", location.DisplayName)
+	d.Set("include_unknown_countries_and_regions", location.IncludeUnknownCountriesAndRegions)
+
+	if err := d.Set("countries_and_regions", flattenCountriesAndRegions(location.CountriesAndRegions)); err != nil {
+		return fmt.Errorf("setting `countries_and_regions`: %+v", err)
+	}
+
+	return nil
+}
+
+func countryNamedLocationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if _, err := client.Delete(ctx, d.Id()); err != nil {
+		return fmt.Errorf("deleting Country Named Location with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCountriesAndRegions(input []interface{}) *[]string {
+	result := make([]string, 0)
+	for _, v := range input {
+		result = append(result, v.(string))
+	}
+
+	return &result
+}
+
+func flattenCountriesAndRegions(input *[]string) []interface{} {
+	result := make([]interface{}, 0)
+	if input != nil {
+		for _, v := range *input {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
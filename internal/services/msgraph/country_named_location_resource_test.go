@@ -0,0 +1,126 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccCountryNamedLocation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_country_named_location", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckCountryNamedLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCountryNamedLocation_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCountryNamedLocationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestCountryNamedLocation-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "countries_and_regions.#", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "include_unknown_countries_and_regions", "false"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccCountryNamedLocation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_country_named_location", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckCountryNamedLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCountryNamedLocation_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCountryNamedLocationExists(data.ResourceName),
+				),
+			},
+			{
+				Config: testAccCountryNamedLocation_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCountryNamedLocationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "countries_and_regions.#", "3"),
+					resource.TestCheckResourceAttr(data.ResourceName, "include_unknown_countries_and_regions", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckCountryNamedLocationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.NamedLocationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Country Named Location %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Country Named Location %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckCountryNamedLocationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_country_named_location" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.NamedLocationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		location, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Country Named Location still exists:\n%#v", location)
+	}
+
+	return nil
+}
+
+func testAccCountryNamedLocation_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_country_named_location" "test" {
+  display_name          = "acctestCountryNamedLocation-%d"
+  countries_and_regions = ["GB", "US"]
+}
+`, id)
+}
+
+func testAccCountryNamedLocation_complete(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_country_named_location" "test" {
+  display_name                          = "acctestCountryNamedLocation-%d"
+  countries_and_regions                 = ["GB", "US", "JP"]
+  include_unknown_countries_and_regions = true
+}
+`, id)
+}
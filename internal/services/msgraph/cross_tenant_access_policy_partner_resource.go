@@ -0,0 +1,208 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func crossTenantAccessPolicyPartnerResource() *schema.Resource {
+	return &schema.Resource{
+		Create: crossTenantAccessPolicyPartnerResourceCreate,
+		Read:   crossTenantAccessPolicyPartnerResourceRead,
+		Update: crossTenantAccessPolicyPartnerResourceUpdate,
+		Delete: crossTenantAccessPolicyPartnerResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not a valid UUID: %+v", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"partner_tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"b2b_collaboration_inbound_access_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"allowed",
+					"blocked",
+				}, false),
+			},
+
+			"b2b_collaboration_outbound_access_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"allowed",
+					"blocked",
+				}, false),
+			},
+
+			"inbound_trust": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mfa_accepted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"compliant_device_accepted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"hybrid_azure_ad_joined_device_accepted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"automatic_user_consent_inbound_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"automatic_user_consent_outbound_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func crossTenantAccessPolicyPartnerResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	tenantId := d.Get("partner_tenant_id").(string)
+
+	properties := expandCrossTenantPartnerConfiguration(d)
+	properties.TenantId = utils.String(tenantId)
+
+	partner, _, err := client.CreatePartner(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Cross-Tenant Access Policy partner configuration for tenant %q: %+v", tenantId, err)
+	}
+	if partner == nil || partner.TenantId == nil || *partner.TenantId == "" {
+		return fmt.Errorf("nil/blank tenant ID returned for Cross-Tenant Access Policy partner configuration")
+	}
+
+	d.SetId(*partner.TenantId)
+
+	return crossTenantAccessPolicyPartnerResourceRead(d, meta)
+}
+
+func crossTenantAccessPolicyPartnerResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := expandCrossTenantPartnerConfiguration(d)
+
+	if _, err := client.UpdatePartner(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Cross-Tenant Access Policy partner configuration for tenant %q: %+v", d.Id(), err)
+	}
+
+	return crossTenantAccessPolicyPartnerResourceRead(d, meta)
+}
+
+func crossTenantAccessPolicyPartnerResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	partner, status, err := client.GetPartner(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Cross-Tenant Access Policy partner configuration for tenant %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Cross-Tenant Access Policy partner configuration for tenant %q: %+v", d.Id(), err)
+	}
+
+	d.Set("partner_tenant_id", partner.TenantId)
+	d.Set("b2b_collaboration_inbound_access_type", flattenCrossTenantB2BSetting(partner.B2BCollaborationInbound))
+	d.Set("b2b_collaboration_outbound_access_type", flattenCrossTenantB2BSetting(partner.B2BCollaborationOutbound))
+	d.Set("inbound_trust", flattenCrossTenantInboundTrust(partner.InboundTrust))
+
+	automaticConsentInbound := false
+	automaticConsentOutbound := false
+	if consent := partner.AutomaticUserConsentSettings; consent != nil {
+		if consent.InboundAllowed != nil {
+			automaticConsentInbound = *consent.InboundAllowed
+		}
+		if consent.OutboundAllowed != nil {
+			automaticConsentOutbound = *consent.OutboundAllowed
+		}
+	}
+	d.Set("automatic_user_consent_inbound_allowed", automaticConsentInbound)
+	d.Set("automatic_user_consent_outbound_allowed", automaticConsentOutbound)
+
+	return nil
+}
+
+func crossTenantAccessPolicyPartnerResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.DeletePartner(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Cross-Tenant Access Policy partner configuration for tenant %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// expandCrossTenantPartnerConfiguration builds the partner configuration from state.
+// B2B collaboration settings and inbound trust are only sent when configured, so that
+// unset properties continue to inherit from the tenant default configuration.
+func expandCrossTenantPartnerConfiguration(d *schema.ResourceData) graph.CrossTenantAccessPolicyConfigurationPartner {
+	properties := graph.CrossTenantAccessPolicyConfigurationPartner{
+		AutomaticUserConsentSettings: &graph.InboundOutboundPolicyConfiguration{
+			InboundAllowed:  utils.Bool(d.Get("automatic_user_consent_inbound_allowed").(bool)),
+			OutboundAllowed: utils.Bool(d.Get("automatic_user_consent_outbound_allowed").(bool)),
+		},
+	}
+
+	if accessType := d.Get("b2b_collaboration_inbound_access_type").(string); accessType != "" {
+		properties.B2BCollaborationInbound = expandCrossTenantB2BSetting(accessType)
+	}
+
+	if accessType := d.Get("b2b_collaboration_outbound_access_type").(string); accessType != "" {
+		properties.B2BCollaborationOutbound = expandCrossTenantB2BSetting(accessType)
+	}
+
+	if trust := d.Get("inbound_trust").([]interface{}); len(trust) > 0 {
+		properties.InboundTrust = expandCrossTenantInboundTrust(trust)
+	}
+
+	return properties
+}
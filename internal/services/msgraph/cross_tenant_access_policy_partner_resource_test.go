@@ -0,0 +1,98 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+// testPartnerTenantId is an arbitrary tenant ID to configure partner settings for; the
+// partner tenant does not need to exist for the configuration to be created.
+const testPartnerTenantId = "44444444-4444-4444-4444-444444444444"
+
+func TestAccCrossTenantAccessPolicyPartner_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy_partner", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckCrossTenantAccessPolicyPartnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCrossTenantAccessPolicyPartner_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCrossTenantAccessPolicyPartnerExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "partner_tenant_id", testPartnerTenantId),
+					resource.TestCheckResourceAttr(data.ResourceName, "automatic_user_consent_inbound_allowed", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckCrossTenantAccessPolicyPartnerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.GetPartner(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Cross-Tenant Access Policy partner configuration for tenant %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Cross-Tenant Access Policy partner configuration for tenant %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckCrossTenantAccessPolicyPartnerDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_cross_tenant_access_policy_partner" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		partner, status, err := client.GetPartner(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Cross-Tenant Access Policy partner configuration still exists:\n%#v", partner)
+	}
+
+	return nil
+}
+
+func testAccCrossTenantAccessPolicyPartner_basic() string {
+	return fmt.Sprintf(`
+resource "azuread_cross_tenant_access_policy_partner" "test" {
+  partner_tenant_id = "%[1]s"
+
+  automatic_user_consent_inbound_allowed  = true
+  automatic_user_consent_outbound_allowed = true
+
+  inbound_trust {
+    mfa_accepted = true
+  }
+}
+`, testPartnerTenantId)
+}
@@ -0,0 +1,209 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+// crossTenantAccessPolicyId is the fixed ID of the tenant-wide default cross-tenant
+// access configuration singleton.
+const crossTenantAccessPolicyId = "crossTenantAccessPolicy"
+
+func crossTenantAccessPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: crossTenantAccessPolicyResourceCreateUpdate,
+		Read:   crossTenantAccessPolicyResourceRead,
+		Update: crossTenantAccessPolicyResourceCreateUpdate,
+		Delete: crossTenantAccessPolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != crossTenantAccessPolicyId {
+				return fmt.Errorf("specified ID (%q) must be %q", id, crossTenantAccessPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"b2b_collaboration_inbound_access_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "allowed",
+				ValidateFunc: validation.StringInSlice([]string{
+					"allowed",
+					"blocked",
+				}, false),
+			},
+
+			"b2b_collaboration_outbound_access_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "allowed",
+				ValidateFunc: validation.StringInSlice([]string{
+					"allowed",
+					"blocked",
+				}, false),
+			},
+
+			"inbound_trust": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mfa_accepted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"compliant_device_accepted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"hybrid_azure_ad_joined_device_accepted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func crossTenantAccessPolicyResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	configuration := graph.CrossTenantAccessPolicyConfigurationDefault{
+		B2BCollaborationInbound:  expandCrossTenantB2BSetting(d.Get("b2b_collaboration_inbound_access_type").(string)),
+		B2BCollaborationOutbound: expandCrossTenantB2BSetting(d.Get("b2b_collaboration_outbound_access_type").(string)),
+		InboundTrust:             expandCrossTenantInboundTrust(d.Get("inbound_trust").([]interface{})),
+	}
+
+	if _, err := client.UpdateDefault(ctx, configuration); err != nil {
+		return fmt.Errorf("updating default Cross-Tenant Access Policy configuration: %+v", err)
+	}
+
+	d.SetId(crossTenantAccessPolicyId)
+
+	return crossTenantAccessPolicyResourceRead(d, meta)
+}
+
+func crossTenantAccessPolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	configuration, _, err := client.GetDefault(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving default Cross-Tenant Access Policy configuration: %+v", err)
+	}
+
+	d.Set("b2b_collaboration_inbound_access_type", flattenCrossTenantB2BSetting(configuration.B2BCollaborationInbound))
+	d.Set("b2b_collaboration_outbound_access_type", flattenCrossTenantB2BSetting(configuration.B2BCollaborationOutbound))
+	d.Set("inbound_trust", flattenCrossTenantInboundTrust(configuration.InboundTrust))
+
+	return nil
+}
+
+// crossTenantAccessPolicyResourceDelete reverts the default configuration to the
+// service defaults, since the underlying policy is a tenant singleton which cannot
+// be deleted.
+func crossTenantAccessPolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if _, err := client.ResetDefault(ctx); err != nil {
+		return fmt.Errorf("resetting default Cross-Tenant Access Policy configuration: %+v", err)
+	}
+
+	return nil
+}
+
+// expandCrossTenantB2BSetting applies the given access type to all users and all
+// applications, which is the granularity the default configuration supports.
+func expandCrossTenantB2BSetting(accessType string) *graph.CrossTenantAccessPolicyB2BSetting {
+	return &graph.CrossTenantAccessPolicyB2BSetting{
+		UsersAndGroups: &graph.CrossTenantAccessPolicyTargetConfiguration{
+			AccessType: utils.String(accessType),
+			Targets: &[]graph.CrossTenantAccessPolicyTarget{
+				{
+					Target:     utils.String("AllUsers"),
+					TargetType: utils.String("user"),
+				},
+			},
+		},
+		Applications: &graph.CrossTenantAccessPolicyTargetConfiguration{
+			AccessType: utils.String(accessType),
+			Targets: &[]graph.CrossTenantAccessPolicyTarget{
+				{
+					Target:     utils.String("AllApplications"),
+					TargetType: utils.String("application"),
+				},
+			},
+		},
+	}
+}
+
+func flattenCrossTenantB2BSetting(setting *graph.CrossTenantAccessPolicyB2BSetting) string {
+	if setting == nil || setting.UsersAndGroups == nil || setting.UsersAndGroups.AccessType == nil {
+		return ""
+	}
+	return *setting.UsersAndGroups.AccessType
+}
+
+func expandCrossTenantInboundTrust(in []interface{}) *graph.CrossTenantAccessPolicyInboundTrust {
+	result := graph.CrossTenantAccessPolicyInboundTrust{
+		IsCompliantDeviceAccepted:           utils.Bool(false),
+		IsHybridAzureADJoinedDeviceAccepted: utils.Bool(false),
+		IsMfaAccepted:                       utils.Bool(false),
+	}
+
+	if len(in) > 0 && in[0] != nil {
+		trust := in[0].(map[string]interface{})
+		result.IsCompliantDeviceAccepted = utils.Bool(trust["compliant_device_accepted"].(bool))
+		result.IsHybridAzureADJoinedDeviceAccepted = utils.Bool(trust["hybrid_azure_ad_joined_device_accepted"].(bool))
+		result.IsMfaAccepted = utils.Bool(trust["mfa_accepted"].(bool))
+	}
+
+	return &result
+}
+
+func flattenCrossTenantInboundTrust(trust *graph.CrossTenantAccessPolicyInboundTrust) []map[string]interface{} {
+	if trust == nil {
+		return []map[string]interface{}{}
+	}
+
+	mfaAccepted := false
+	if trust.IsMfaAccepted != nil {
+		mfaAccepted = *trust.IsMfaAccepted
+	}
+
+	compliantDeviceAccepted := false
+	if trust.IsCompliantDeviceAccepted != nil {
+		compliantDeviceAccepted = *trust.IsCompliantDeviceAccepted
+	}
+
+	hybridJoinedDeviceAccepted := false
+	if trust.IsHybridAzureADJoinedDeviceAccepted != nil {
+		hybridJoinedDeviceAccepted = *trust.IsHybridAzureADJoinedDeviceAccepted
+	}
+
+	return []map[string]interface{}{
+		{
+			"mfa_accepted":                           mfaAccepted,
+			"compliant_device_accepted":              compliantDeviceAccepted,
+			"hybrid_azure_ad_joined_device_accepted": hybridJoinedDeviceAccepted,
+		},
+	}
+}
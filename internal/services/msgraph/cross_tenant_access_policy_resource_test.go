@@ -0,0 +1,72 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccCrossTenantAccessPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy", "test")
+
+	// not Parallel, as the default cross-tenant access configuration is a tenant singleton
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckCrossTenantAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCrossTenantAccessPolicy_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCrossTenantAccessPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "b2b_collaboration_inbound_access_type", "allowed"),
+					resource.TestCheckResourceAttr(data.ResourceName, "inbound_trust.0.mfa_accepted", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckCrossTenantAccessPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CrossTenantAccessPolicyClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, _, err := client.GetDefault(ctx); err != nil {
+			return fmt.Errorf("retrieving default Cross-Tenant Access Policy configuration %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckCrossTenantAccessPolicyDestroy(s *terraform.State) error {
+	// the underlying configuration is a tenant singleton which is reset to the service
+	// defaults on deletion, so there is nothing to verify here
+	return nil
+}
+
+func testAccCrossTenantAccessPolicy_basic() string {
+	return `
+resource "azuread_cross_tenant_access_policy" "test" {
+  b2b_collaboration_inbound_access_type  = "allowed"
+  b2b_collaboration_outbound_access_type = "allowed"
+
+  inbound_trust {
+    mfa_accepted              = true
+    compliant_device_accepted = true
+  }
+}
+`
+}
@@ -0,0 +1,133 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func crossTenantSynchronizationConfigurationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: crossTenantSynchronizationConfigurationResourceCreate,
+		Read:   crossTenantSynchronizationConfigurationResourceRead,
+		Delete: crossTenantSynchronizationConfigurationResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseSynchronizationJobId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Azure2Azure",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"schedule_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func crossTenantSynchronizationConfigurationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	servicePrincipalId := d.Get("service_principal_id").(string)
+
+	properties := graph.SynchronizationJob{
+		TemplateId: utils.String(d.Get("template_id").(string)),
+	}
+
+	job, _, err := client.CreateJob(ctx, servicePrincipalId, properties)
+	if err != nil {
+		return fmt.Errorf("creating cross-tenant synchronization job for service principal %q: %+v", servicePrincipalId, err)
+	}
+	if job == nil || job.ID == nil || *job.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for cross-tenant synchronization job")
+	}
+
+	if _, err := client.StartJob(ctx, servicePrincipalId, *job.ID); err != nil {
+		return fmt.Errorf("starting cross-tenant synchronization job %q: %+v", *job.ID, err)
+	}
+
+	d.SetId(graph.SynchronizationJobIdFrom(servicePrincipalId, *job.ID).String())
+
+	return crossTenantSynchronizationConfigurationResourceRead(d, meta)
+}
+
+func crossTenantSynchronizationConfigurationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseSynchronizationJobId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	job, status, err := client.GetJob(ctx, id.ServicePrincipalId, id.JobId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Synchronization Job %q was not found on service principal %q - removing from state", id.JobId, id.ServicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Synchronization Job %q: %+v", id.JobId, err)
+	}
+
+	d.Set("service_principal_id", id.ServicePrincipalId)
+	d.Set("template_id", job.TemplateId)
+	d.Set("job_id", job.ID)
+
+	scheduleState := ""
+	if job.Schedule != nil && job.Schedule.State != nil {
+		scheduleState = *job.Schedule.State
+	}
+	d.Set("schedule_state", scheduleState)
+
+	return nil
+}
+
+func crossTenantSynchronizationConfigurationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseSynchronizationJobId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if status, err := client.DeleteJob(ctx, id.ServicePrincipalId, id.JobId); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Synchronization Job %q: %+v", id.JobId, err)
+		}
+	}
+
+	return nil
+}
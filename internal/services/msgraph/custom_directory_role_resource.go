@@ -0,0 +1,215 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func customDirectoryRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: customDirectoryRoleResourceCreate,
+		Read:   customDirectoryRoleResourceRead,
+		Update: customDirectoryRoleResourceUpdate,
+		Delete: customDirectoryRoleResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_resource_actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func customDirectoryRoleResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleDefinitionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.UnifiedRoleDefinition{
+		Description:     utils.String(d.Get("description").(string)),
+		DisplayName:     utils.String(d.Get("display_name").(string)),
+		IsEnabled:       utils.Bool(d.Get("enabled").(bool)),
+		RolePermissions: expandCustomRolePermissions(d.Get("permissions").(*schema.Set).List()),
+		Version:         utils.String(d.Get("version").(string)),
+	}
+
+	if templateId, ok := d.GetOk("template_id"); ok {
+		properties.TemplateId = utils.String(templateId.(string))
+	}
+
+	roleDefinition, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Custom Directory Role %q: %+v", d.Get("display_name").(string), err)
+	}
+
+	if roleDefinition.ID == nil || *roleDefinition.ID == "" {
+		return fmt.Errorf("Custom Directory Role ID is empty or nil")
+	}
+
+	d.SetId(*roleDefinition.ID)
+
+	return customDirectoryRoleResourceRead(d, meta)
+}
+
+func customDirectoryRoleResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleDefinitionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.UnifiedRoleDefinition{
+		Description:     utils.String(d.Get("description").(string)),
+		DisplayName:     utils.String(d.Get("display_name").(string)),
+		IsEnabled:       utils.Bool(d.Get("enabled").(bool)),
+		RolePermissions: expandCustomRolePermissions(d.Get("permissions").(*schema.Set).List()),
+		Version:         utils.String(d.Get("version").(string)),
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Custom Directory Role with ID %q: %+v", d.Id(), err)
+	}
+
+	return customDirectoryRoleResourceRead(d, meta)
+}
+
+func customDirectoryRoleResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleDefinitionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	roleDefinition, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Custom Directory Role with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Custom Directory Role with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("object_id", roleDefinition.ID)
+	d.Set("display_name", roleDefinition.DisplayName)
+	d.Set("description", roleDefinition.Description)
+	d.Set("enabled", roleDefinition.IsEnabled)
+	d.Set("template_id", roleDefinition.TemplateId)
+	d.Set("version", roleDefinition.Version)
+
+	if err := d.Set("permissions", flattenCustomRolePermissions(roleDefinition.RolePermissions)); err != nil {
+		return fmt.Errorf("setting `permissions`: %+v", err)
+	}
+
+	return nil
+}
+
+func customDirectoryRoleResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleDefinitionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if _, err := client.Delete(ctx, d.Id()); err != nil {
+		return fmt.Errorf("deleting Custom Directory Role with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCustomRolePermissions(input []interface{}) *[]graph.UnifiedRolePermission {
+	result := make([]graph.UnifiedRolePermission, 0)
+	for _, v := range input {
+		permission := v.(map[string]interface{})
+
+		allowedResourceActions := make([]string, 0)
+		for _, action := range permission["allowed_resource_actions"].(*schema.Set).List() {
+			allowedResourceActions = append(allowedResourceActions, action.(string))
+		}
+
+		result = append(result, graph.UnifiedRolePermission{
+			AllowedResourceActions: &allowedResourceActions,
+		})
+	}
+
+	return &result
+}
+
+func flattenCustomRolePermissions(input *[]graph.UnifiedRolePermission) []interface{} {
+	result := make([]interface{}, 0)
+	if input != nil {
+		for _, permission := range *input {
+			allowedResourceActions := make([]interface{}, 0)
+			if permission.AllowedResourceActions != nil {
+				for _, action := range *permission.AllowedResourceActions {
+					allowedResourceActions = append(allowedResourceActions, action)
+				}
+			}
+
+			result = append(result, map[string]interface{}{
+				"allowed_resource_actions": allowedResourceActions,
+			})
+		}
+	}
+
+	return result
+}
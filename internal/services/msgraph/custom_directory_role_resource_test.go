@@ -0,0 +1,148 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccCustomDirectoryRole_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_directory_role", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckCustomDirectoryRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDirectoryRole_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCustomDirectoryRoleExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestCustomRole-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(data.ResourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccCustomDirectoryRole_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_directory_role", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckCustomDirectoryRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDirectoryRole_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCustomDirectoryRoleExists(data.ResourceName),
+				),
+			},
+			{
+				Config: testAccCustomDirectoryRole_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCustomDirectoryRoleExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "description", "Can read applications and update basic application properties"),
+					resource.TestCheckResourceAttr(data.ResourceName, "permissions.#", "2"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckCustomDirectoryRoleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.RoleDefinitionsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Custom Directory Role %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Custom Directory Role %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckCustomDirectoryRoleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_custom_directory_role" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.RoleDefinitionsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		roleDefinition, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Custom Directory Role still exists:\n%#v", roleDefinition)
+	}
+
+	return nil
+}
+
+func testAccCustomDirectoryRole_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_custom_directory_role" "test" {
+  display_name = "acctestCustomRole-%d"
+  enabled      = true
+  version      = "1.0"
+
+  permissions {
+    allowed_resource_actions = [
+      "microsoft.directory/applications/basic/update",
+      "microsoft.directory/applications/standard/read",
+    ]
+  }
+}
+`, id)
+}
+
+func testAccCustomDirectoryRole_complete(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_custom_directory_role" "test" {
+  display_name = "acctestCustomRole-%d"
+  description  = "Can read applications and update basic application properties"
+  enabled      = true
+  version      = "1.1"
+
+  permissions {
+    allowed_resource_actions = [
+      "microsoft.directory/applications/standard/read",
+    ]
+  }
+
+  permissions {
+    allowed_resource_actions = [
+      "microsoft.directory/applications/basic/update",
+    ]
+  }
+}
+`, id)
+}
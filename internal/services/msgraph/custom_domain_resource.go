@@ -0,0 +1,210 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func customDomainResource() *schema.Resource {
+	return &schema.Resource{
+		Create: customDomainResourceCreate,
+		Read:   customDomainResourceRead,
+		Delete: customDomainResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the fully qualified name of the domain")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"verification_dns_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"supported_service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"mail_exchange": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"preference": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func customDomainResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DomainsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	domainName := d.Get("domain_name").(string)
+
+	domain, _, err := client.Create(ctx, graph.Domain{
+		ID: utils.String(domainName),
+	})
+	if err != nil {
+		return fmt.Errorf("creating Custom Domain %q: %+v", domainName, err)
+	}
+	if domain == nil || domain.ID == nil || *domain.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Custom Domain %q", domainName)
+	}
+
+	d.SetId(*domain.ID)
+
+	return customDomainResourceRead(d, meta)
+}
+
+func customDomainResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DomainsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	domain, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Custom Domain %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Custom Domain %q: %+v", d.Id(), err)
+	}
+
+	d.Set("domain_name", domain.ID)
+	d.Set("authentication_type", domain.AuthenticationType)
+	d.Set("is_default", domain.IsDefault)
+	d.Set("is_verified", domain.IsVerified)
+
+	dnsRecords, _, err := client.ListVerificationDnsRecords(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("retrieving verification DNS records for Custom Domain %q: %+v", d.Id(), err)
+	}
+	d.Set("verification_dns_records", flattenCustomDomainDnsRecords(dnsRecords))
+
+	return nil
+}
+
+func customDomainResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DomainsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Custom Domain %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func flattenCustomDomainDnsRecords(dnsRecords []graph.DomainDnsRecord) []interface{} {
+	result := make([]interface{}, 0)
+	for _, record := range dnsRecords {
+		label := ""
+		if record.Label != nil {
+			label = *record.Label
+		}
+
+		recordType := ""
+		if record.RecordType != nil {
+			recordType = *record.RecordType
+		}
+
+		supportedService := ""
+		if record.SupportedService != nil {
+			supportedService = *record.SupportedService
+		}
+
+		text := ""
+		if record.Text != nil {
+			text = *record.Text
+		}
+
+		mailExchange := ""
+		if record.MailExchange != nil {
+			mailExchange = *record.MailExchange
+		}
+
+		preference := 0
+		if record.Preference != nil {
+			preference = int(*record.Preference)
+		}
+
+		ttl := 0
+		if record.Ttl != nil {
+			ttl = int(*record.Ttl)
+		}
+
+		result = append(result, map[string]interface{}{
+			"label":             label,
+			"record_type":       recordType,
+			"supported_service": supportedService,
+			"text":              text,
+			"mail_exchange":     mailExchange,
+			"preference":        preference,
+			"ttl":               ttl,
+		})
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccCustomDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_domain", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDomain_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCustomDomainExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "is_verified", "false"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "verification_dns_records.0.record_type"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckCustomDomainExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.DomainsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Custom Domain %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Custom Domain %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckCustomDomainDestroy(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.DomainsClient
+	ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_custom_domain" {
+			continue
+		}
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving Custom Domain %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Custom Domain %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCustomDomain_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_custom_domain" "test" {
+  domain_name = "acctest%[1]d.example.com"
+}
+`, id)
+}
@@ -0,0 +1,110 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func customDomainVerificationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: customDomainVerificationResourceCreate,
+		Read:   customDomainVerificationResourceRead,
+		Delete: customDomainVerificationResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the fully qualified name of the domain")
+			}
+			return nil
+		}),
+
+		// DNS propagation can be slow, so allow the verification window to be raised
+		// with a `timeouts` block
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func customDomainVerificationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DomainsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	domainName := d.Get("domain_name").(string)
+
+	// retry verification for a while, since the DNS records proving ownership are
+	// typically created in the same apply and take time to propagate
+	_, err := (&resource.StateChangeConf{
+		Pending:    []string{"Unverified"},
+		Target:     []string{"Verified"},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 15 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			domain, _, err := client.Verify(ctx, domainName)
+			if err != nil {
+				return nil, "Unverified", nil
+			}
+			if domain != nil && domain.IsVerified != nil && *domain.IsVerified {
+				return domain, "Verified", nil
+			}
+			return domain, "Unverified", nil
+		},
+	}).WaitForState()
+	if err != nil {
+		return fmt.Errorf("verifying Custom Domain %q: %+v", domainName, err)
+	}
+
+	d.SetId(domainName)
+
+	return customDomainVerificationResourceRead(d, meta)
+}
+
+func customDomainVerificationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DomainsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	domain, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Custom Domain %q was not found - removing verification from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Custom Domain %q: %+v", d.Id(), err)
+	}
+
+	if domain.IsVerified == nil || !*domain.IsVerified {
+		log.Printf("[DEBUG] Custom Domain %q is no longer verified - removing verification from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("domain_name", domain.ID)
+
+	return nil
+}
+
+func customDomainVerificationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	// domain verification cannot be revoked, so deletion simply removes the
+	// verification from state
+	log.Printf("[DEBUG] Verification of Custom Domain %q cannot be revoked - removing from state only", d.Id())
+	return nil
+}
@@ -0,0 +1,78 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccCustomDomainVerification_basic(t *testing.T) {
+	// verification requires proving ownership of the domain, so the DNS records for a
+	// domain already added to the tenant must be provisioned before running this test
+	domainName := os.Getenv("ARM_TEST_CUSTOM_DOMAIN_NAME")
+	if domainName == "" {
+		t.Skip("skipping as `ARM_TEST_CUSTOM_DOMAIN_NAME` was not specified in the environment")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_custom_domain_verification", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDomainVerification_basic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCustomDomainVerified(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "domain_name", domainName),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckCustomDomainVerified(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.DomainsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		domain, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Custom Domain %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Custom Domain %q: %+v", rs.Primary.ID, err)
+		}
+
+		if domain.IsVerified == nil || !*domain.IsVerified {
+			return fmt.Errorf("Custom Domain %q is not verified", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCustomDomainVerification_basic(domainName string) string {
+	return fmt.Sprintf(`
+resource "azuread_custom_domain_verification" "test" {
+  domain_name = "%[1]s"
+
+  timeouts {
+    create = "15m"
+  }
+}
+`, domainName)
+}
@@ -0,0 +1,253 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+func customSecurityAttributeDefinitionResource() *schema.Resource {
+	return &schema.Resource{
+		Create: customSecurityAttributeDefinitionResourceCreate,
+		Read:   customSecurityAttributeDefinitionResourceRead,
+		Update: customSecurityAttributeDefinitionResourceUpdate,
+		Delete: customSecurityAttributeDefinitionResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			return graph.ParseCustomSecurityAttributeDefinitionId(id)
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"attribute_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Boolean",
+					"Integer",
+					"String",
+				}, false),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"multi_valued": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"searchable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"use_predefined_values_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allowed_values": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func customSecurityAttributeDefinitionResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.CustomSecurityAttributeDefinition{
+		AttributeSet:            utils.String(d.Get("attribute_set_name").(string)),
+		Description:             utils.String(d.Get("description").(string)),
+		IsCollection:            utils.Bool(d.Get("multi_valued").(bool)),
+		IsSearchable:            utils.Bool(d.Get("searchable").(bool)),
+		Name:                    utils.String(d.Get("name").(string)),
+		Status:                  utils.String(graph.CustomSecurityAttributeStatusAvailable),
+		Type:                    utils.String(d.Get("type").(string)),
+		UsePreDefinedValuesOnly: utils.Bool(d.Get("use_predefined_values_only").(bool)),
+	}
+
+	if v, ok := d.GetOk("allowed_values"); ok {
+		allowedValues := make([]graph.AllowedValue, 0)
+		for _, value := range v.(*schema.Set).List() {
+			allowedValues = append(allowedValues, graph.AllowedValue{
+				ID:       utils.String(value.(string)),
+				IsActive: utils.Bool(true),
+			})
+		}
+		properties.AllowedValues = &allowedValues
+	}
+
+	definition, _, err := client.CreateDefinition(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Custom Security Attribute Definition %q: %+v", d.Get("name").(string), err)
+	}
+	if definition == nil || definition.ID == nil || *definition.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Custom Security Attribute Definition")
+	}
+
+	d.SetId(*definition.ID)
+
+	return customSecurityAttributeDefinitionResourceRead(d, meta)
+}
+
+func customSecurityAttributeDefinitionResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if d.HasChange("description") || d.HasChange("use_predefined_values_only") {
+		properties := graph.CustomSecurityAttributeDefinition{
+			Description:             utils.String(d.Get("description").(string)),
+			UsePreDefinedValuesOnly: utils.Bool(d.Get("use_predefined_values_only").(bool)),
+		}
+
+		if _, err := client.UpdateDefinition(ctx, d.Id(), properties); err != nil {
+			return fmt.Errorf("updating Custom Security Attribute Definition %q: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("allowed_values") {
+		if err := customSecurityAttributeDefinitionSyncAllowedValues(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return customSecurityAttributeDefinitionResourceRead(d, meta)
+}
+
+// customSecurityAttributeDefinitionSyncAllowedValues adds newly configured allowed
+// values and deactivates removed ones, since allowed values cannot be deleted.
+func customSecurityAttributeDefinitionSyncAllowedValues(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	old, new := d.GetChange("allowed_values")
+	oldValues := old.(*schema.Set)
+	newValues := new.(*schema.Set)
+
+	for _, value := range newValues.Difference(oldValues).List() {
+		properties := graph.AllowedValue{
+			ID:       utils.String(value.(string)),
+			IsActive: utils.Bool(true),
+		}
+		if _, err := client.AddAllowedValue(ctx, d.Id(), properties); err != nil {
+			// the value may already exist in a deactivated state, in which case it is reactivated
+			if _, updateErr := client.UpdateAllowedValue(ctx, d.Id(), value.(string), graph.AllowedValue{IsActive: utils.Bool(true)}); updateErr != nil {
+				return fmt.Errorf("adding allowed value %q for Custom Security Attribute Definition %q: %+v", value.(string), d.Id(), err)
+			}
+		}
+	}
+
+	for _, value := range oldValues.Difference(newValues).List() {
+		properties := graph.AllowedValue{
+			IsActive: utils.Bool(false),
+		}
+		if _, err := client.UpdateAllowedValue(ctx, d.Id(), value.(string), properties); err != nil {
+			return fmt.Errorf("deactivating allowed value %q for Custom Security Attribute Definition %q: %+v", value.(string), d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func customSecurityAttributeDefinitionResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	definition, status, err := client.GetDefinition(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Custom Security Attribute Definition %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Custom Security Attribute Definition %q: %+v", d.Id(), err)
+	}
+
+	d.Set("attribute_set_name", definition.AttributeSet)
+	d.Set("name", definition.Name)
+	d.Set("type", definition.Type)
+	d.Set("description", definition.Description)
+	d.Set("multi_valued", definition.IsCollection)
+	d.Set("searchable", definition.IsSearchable)
+	d.Set("use_predefined_values_only", definition.UsePreDefinedValuesOnly)
+	d.Set("status", definition.Status)
+
+	allowedValues, _, err := client.ListAllowedValues(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("retrieving allowed values for Custom Security Attribute Definition %q: %+v", d.Id(), err)
+	}
+
+	activeValues := make([]string, 0)
+	for _, value := range allowedValues {
+		if value.ID != nil && (value.IsActive == nil || *value.IsActive) {
+			activeValues = append(activeValues, *value.ID)
+		}
+	}
+	if err := d.Set("allowed_values", activeValues); err != nil {
+		return fmt.Errorf("setting `allowed_values`: %+v", err)
+	}
+
+	return nil
+}
+
+// customSecurityAttributeDefinitionResourceDelete deprecates the definition, since
+// custom security attribute definitions cannot be deleted from the directory.
+func customSecurityAttributeDefinitionResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.CustomSecurityAttributeDefinition{
+		Status: utils.String(graph.CustomSecurityAttributeStatusDeprecated),
+	}
+
+	if status, err := client.UpdateDefinition(ctx, d.Id(), properties); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deprecating Custom Security Attribute Definition %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
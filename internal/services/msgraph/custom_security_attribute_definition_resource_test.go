@@ -0,0 +1,104 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+// testAttributeSetName must already exist in the test tenant, since attribute sets
+// cannot be deleted and are therefore not created as part of this test.
+const testAttributeSetName = "acctest"
+
+func TestAccCustomSecurityAttributeDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_security_attribute_definition", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckCustomSecurityAttributeDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomSecurityAttributeDefinition_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckCustomSecurityAttributeDefinitionExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "type", "String"),
+					resource.TestCheckResourceAttr(data.ResourceName, "status", "Available"),
+					resource.TestCheckResourceAttr(data.ResourceName, "allowed_values.#", "2"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckCustomSecurityAttributeDefinitionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.GetDefinition(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Custom Security Attribute Definition %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Custom Security Attribute Definition %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckCustomSecurityAttributeDefinitionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_custom_security_attribute_definition" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		definition, status, err := client.GetDefinition(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		// definitions cannot be deleted, so deletion is verified by the deprecated status
+		if definition.Status != nil && *definition.Status == graph.CustomSecurityAttributeStatusDeprecated {
+			return nil
+		}
+
+		return fmt.Errorf("Custom Security Attribute Definition still active:\n%#v", definition)
+	}
+
+	return nil
+}
+
+func testAccCustomSecurityAttributeDefinition_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set_name         = "%[1]s"
+  name                       = "acctestAttr%[2]d"
+  type                       = "String"
+  description                = "Acceptance test attribute"
+  use_predefined_values_only = true
+
+  allowed_values = ["alpha", "beta"]
+}
+`, testAttributeSetName, id)
+}
@@ -0,0 +1,97 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func deletedItemsData() *schema.Resource {
+	return &schema.Resource{
+		Read: deletedItemsDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"application",
+					"group",
+				}, false),
+			},
+
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"deleted_date_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func deletedItemsDataRead(d *schema.ResourceData, meta interface{}) error {
+	tenantId := meta.(*clients.AadClient).TenantID
+	client := meta.(*clients.AadClient).MsGraph.DeletedItemsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	itemType := d.Get("type").(string)
+
+	result, err := client.List(ctx, fmt.Sprintf("microsoft.graph.%s", itemType))
+	if err != nil {
+		return fmt.Errorf("listing deleted %ss: %+v", itemType, err)
+	}
+
+	d.SetId(fmt.Sprintf("deletedItems-%s-%s", itemType, tenantId))
+
+	if err = d.Set("items", flattenDeletedItems(result)); err != nil {
+		return fmt.Errorf("setting `items`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDeletedItems(input []graph.DeletedItem) []interface{} {
+	items := make([]interface{}, 0)
+	for _, v := range input {
+		item := map[string]interface{}{
+			"object_id":         "",
+			"display_name":      "",
+			"deleted_date_time": "",
+		}
+
+		if v.ID != nil {
+			item["object_id"] = *v.ID
+		}
+		if v.DisplayName != nil {
+			item["display_name"] = *v.DisplayName
+		}
+		if v.DeletedDateTime != nil {
+			item["deleted_date_time"] = *v.DeletedDateTime
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
@@ -0,0 +1,55 @@
+package msgraph_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccDeletedItemsDataSource_applications(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_deleted_items", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeletedItemsDataSource_applications,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeletedItemsDataSource_groups(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_deleted_items", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeletedItemsDataSource_groups,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDeletedItemsDataSource_applications = `
+data "azuread_deleted_items" "test" {
+  type = "application"
+}
+`
+
+const testAccDeletedItemsDataSource_groups = `
+data "azuread_deleted_items" "test" {
+  type = "group"
+}
+`
@@ -0,0 +1,133 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func deviceData() *schema.Resource {
+	return &schema.Resource{
+		Read: deviceDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"device_id", "display_name", "object_id"},
+				ValidateFunc: validate.UUID,
+			},
+
+			"device_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"device_id", "display_name", "object_id"},
+				ValidateFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"device_id", "display_name", "object_id"},
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"extension_attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_system_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"profile_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"trust_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"approximate_last_sign_in_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func deviceDataRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DevicesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	var device *graph.Device
+
+	if objectId, ok := d.Get("object_id").(string); ok && objectId != "" {
+		resp, _, err := client.Get(ctx, objectId)
+		if err != nil {
+			return fmt.Errorf("retrieving device with object ID %q: %+v", objectId, err)
+		}
+		device = resp
+	} else {
+		var filter string
+		if deviceId, ok := d.Get("device_id").(string); ok && deviceId != "" {
+			filter = fmt.Sprintf("deviceId eq '%s'", deviceId)
+		} else if displayName, ok := d.Get("display_name").(string); ok && displayName != "" {
+			filter = fmt.Sprintf("displayName eq '%s'", displayName)
+		}
+
+		devices, _, err := client.List(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("listing devices for filter %q: %+v", filter, err)
+		}
+
+		if len(devices) == 0 {
+			return fmt.Errorf("found no device matching filter %q", filter)
+		}
+		if len(devices) > 1 {
+			return fmt.Errorf("found multiple devices matching filter %q", filter)
+		}
+
+		device = &devices[0]
+	}
+
+	if device.ID == nil {
+		return fmt.Errorf("nil object ID returned for device")
+	}
+
+	d.SetId(*device.ID)
+	d.Set("object_id", device.ID)
+	d.Set("device_id", device.DeviceId)
+	d.Set("display_name", device.DisplayName)
+	d.Set("enabled", device.AccountEnabled)
+	d.Set("extension_attributes", flattenDeviceExtensionAttributes(device.ExtensionAttributes))
+	d.Set("operating_system", device.OperatingSystem)
+	d.Set("operating_system_version", device.OperatingSystemVersion)
+	d.Set("profile_type", device.ProfileType)
+	d.Set("trust_type", device.TrustType)
+	d.Set("approximate_last_sign_in_date_time", device.ApproximateLastSignInDateTime)
+
+	return nil
+}
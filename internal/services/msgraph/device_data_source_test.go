@@ -0,0 +1,43 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccDeviceDataSource_byObjectId(t *testing.T) {
+	deviceObjectId := os.Getenv("ARM_TEST_DEVICE_OBJECT_ID")
+	if deviceObjectId == "" {
+		t.Skip("skipping as `ARM_TEST_DEVICE_OBJECT_ID` was not specified in the environment")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_device", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceDataSource_byObjectId(deviceObjectId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "device_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "display_name"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "operating_system"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeviceDataSource_byObjectId(deviceObjectId string) string {
+	return fmt.Sprintf(`
+data "azuread_device" "test" {
+  object_id = "%s"
+}
+`, deviceObjectId)
+}
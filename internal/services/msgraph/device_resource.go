@@ -0,0 +1,232 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+var deviceExtensionAttributeRegex = regexp.MustCompile(`^extensionAttribute([1-9]|1[0-5])$`)
+
+func deviceResource() *schema.Resource {
+	return &schema.Resource{
+		Create: deviceResourceCreate,
+		Read:   deviceResourceRead,
+		Update: deviceResourceUpdate,
+		Delete: deviceResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			// devices are registered out of band, so this resource adopts an existing
+			// device object rather than creating one
+			"device_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"extension_attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"device_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"operating_system_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"profile_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"trust_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"approximate_last_sign_in_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func deviceResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DevicesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	deviceId := d.Get("device_object_id").(string)
+
+	if _, status, err := client.Get(ctx, deviceId); err != nil {
+		if status == http.StatusNotFound {
+			return fmt.Errorf("device with object ID %q was not found", deviceId)
+		}
+		return fmt.Errorf("retrieving device with object ID %q: %+v", deviceId, err)
+	}
+
+	extensionAttributes, err := expandDeviceExtensionAttributes(d.Get("extension_attributes").(map[string]interface{}), nil)
+	if err != nil {
+		return err
+	}
+
+	properties := graph.Device{
+		AccountEnabled:      utils.Bool(d.Get("enabled").(bool)),
+		ExtensionAttributes: extensionAttributes,
+	}
+
+	if _, err := client.Update(ctx, deviceId, properties); err != nil {
+		return fmt.Errorf("updating device with object ID %q: %+v", deviceId, err)
+	}
+
+	d.SetId(deviceId)
+
+	return deviceResourceRead(d, meta)
+}
+
+func deviceResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DevicesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.Device{}
+
+	if d.HasChange("enabled") {
+		properties.AccountEnabled = utils.Bool(d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("extension_attributes") {
+		old, new := d.GetChange("extension_attributes")
+		extensionAttributes, err := expandDeviceExtensionAttributes(new.(map[string]interface{}), old.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		properties.ExtensionAttributes = extensionAttributes
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating device with object ID %q: %+v", d.Id(), err)
+	}
+
+	return deviceResourceRead(d, meta)
+}
+
+func deviceResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DevicesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	device, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Device with object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving device with object ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("device_object_id", d.Id())
+	d.Set("enabled", device.AccountEnabled)
+	d.Set("extension_attributes", flattenDeviceExtensionAttributes(device.ExtensionAttributes))
+	d.Set("device_id", device.DeviceId)
+	d.Set("display_name", device.DisplayName)
+	d.Set("operating_system", device.OperatingSystem)
+	d.Set("operating_system_version", device.OperatingSystemVersion)
+	d.Set("profile_type", device.ProfileType)
+	d.Set("trust_type", device.TrustType)
+	d.Set("approximate_last_sign_in_date_time", device.ApproximateLastSignInDateTime)
+
+	return nil
+}
+
+func deviceResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DevicesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting device with object ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// expandDeviceExtensionAttributes maps the configured attributes, additionally
+// blanking any attributes present in the previous configuration but since removed.
+func expandDeviceExtensionAttributes(in, old map[string]interface{}) (*map[string]string, error) {
+	if len(in) == 0 && len(old) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]string)
+	for k, v := range in {
+		if !deviceExtensionAttributeRegex.MatchString(k) {
+			return nil, fmt.Errorf("unrecognised extension attribute %q: expected a key in the form `extensionAttribute1` through `extensionAttribute15`", k)
+		}
+		result[k] = v.(string)
+	}
+
+	for k := range old {
+		if _, ok := in[k]; !ok {
+			result[k] = ""
+		}
+	}
+
+	return &result, nil
+}
+
+func flattenDeviceExtensionAttributes(in *map[string]string) map[string]interface{} {
+	result := make(map[string]interface{})
+	if in == nil {
+		return result
+	}
+
+	for k, v := range *in {
+		// the API returns all fifteen attributes regardless of whether they hold a value
+		if v != "" {
+			result[k] = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,65 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccDevice_basic(t *testing.T) {
+	// devices are registered out of band, so the test adopts an existing device
+	deviceObjectId := os.Getenv("ARM_TEST_DEVICE_OBJECT_ID")
+	if deviceObjectId == "" {
+		t.Skip("skipping as `ARM_TEST_DEVICE_OBJECT_ID` was not specified in the environment")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_device", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevice_basic(deviceObjectId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(data.ResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "device_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "display_name"),
+				),
+			},
+			{
+				Config: testAccDevice_extensionAttributes(deviceObjectId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(data.ResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(data.ResourceName, "extension_attributes.extensionAttribute1", "testing"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testAccDevice_basic(deviceObjectId string) string {
+	return fmt.Sprintf(`
+resource "azuread_device" "test" {
+  device_object_id = "%s"
+}
+`, deviceObjectId)
+}
+
+func testAccDevice_extensionAttributes(deviceObjectId string) string {
+	return fmt.Sprintf(`
+resource "azuread_device" "test" {
+  device_object_id = "%s"
+  enabled          = false
+
+  extension_attributes = {
+    extensionAttribute1 = "testing"
+  }
+}
+`, deviceObjectId)
+}
@@ -0,0 +1,111 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func directoryRoleAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: directoryRoleAssignmentResourceCreate,
+		Read:   directoryRoleAssignmentResourceRead,
+		Delete: directoryRoleAssignmentResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID is blank")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"role_definition_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"directory_scope_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "/",
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func directoryRoleAssignmentResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.UnifiedRoleAssignment{
+		DirectoryScopeId: utils.String(d.Get("directory_scope_id").(string)),
+		PrincipalId:      utils.String(d.Get("principal_object_id").(string)),
+		RoleDefinitionId: utils.String(d.Get("role_definition_id").(string)),
+	}
+
+	roleAssignment, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Directory Role Assignment: %+v", err)
+	}
+
+	if roleAssignment.ID == nil || *roleAssignment.ID == "" {
+		return fmt.Errorf("Directory Role Assignment ID is empty or nil")
+	}
+
+	d.SetId(*roleAssignment.ID)
+
+	return directoryRoleAssignmentResourceRead(d, meta)
+}
+
+func directoryRoleAssignmentResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	roleAssignment, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Directory Role Assignment with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Directory Role Assignment with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("role_definition_id", roleAssignment.RoleDefinitionId)
+	d.Set("principal_object_id", roleAssignment.PrincipalId)
+	d.Set("directory_scope_id", roleAssignment.DirectoryScopeId)
+
+	return nil
+}
+
+func directoryRoleAssignmentResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if _, err := client.Delete(ctx, d.Id()); err != nil {
+		return fmt.Errorf("deleting Directory Role Assignment with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccDirectoryRoleAssignment_tenantScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckDirectoryRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRoleAssignment_tenantScope(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "role_definition_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "principal_object_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "directory_scope_id", "/"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccDirectoryRoleAssignment_administrativeUnitScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckDirectoryRoleAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRoleAssignment_administrativeUnitScope(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "role_definition_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "principal_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "directory_scope_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckDirectoryRoleAssignmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_directory_role_assignment" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.RoleAssignmentsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		roleAssignment, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Directory Role Assignment still exists:\n%#v", roleAssignment)
+	}
+
+	return nil
+}
+
+func testAccDirectoryRoleAssignment_tenantScope(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Directory Readers"
+}
+
+resource "azuread_directory_role_assignment" "test" {
+  role_definition_id  = azuread_directory_role.test.template_id
+  principal_object_id = azuread_user.test.id
+}
+`, id, password)
+}
+
+func testAccDirectoryRoleAssignment_administrativeUnitScope(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_administrative_unit" "test" {
+  display_name = "acctestAdministrativeUnit-%[1]d"
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Helpdesk Administrator"
+}
+
+resource "azuread_directory_role_assignment" "test" {
+  role_definition_id  = azuread_directory_role.test.template_id
+  principal_object_id = azuread_user.test.id
+  directory_scope_id  = "/administrativeUnits/${azuread_administrative_unit.test.id}"
+}
+`, id, password)
+}
@@ -0,0 +1,169 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func directoryRoleEligibilityScheduleRequestResource() *schema.Resource {
+	return &schema.Resource{
+		Create: directoryRoleEligibilityScheduleRequestResourceCreate,
+		Read:   directoryRoleEligibilityScheduleRequestResourceRead,
+		Delete: directoryRoleEligibilityScheduleRequestResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID is blank")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"role_definition_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"justification": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"directory_scope_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "/",
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"expiration_date_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+
+			"start_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func directoryRoleEligibilityScheduleRequestResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleEligibilityScheduleRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	expiration := graph.RoleScheduleExpiration{
+		Type: utils.String(graph.RoleScheduleExpirationTypeNoExpiration),
+	}
+	if endDateTime, ok := d.GetOk("expiration_date_time"); ok {
+		expiration.Type = utils.String(graph.RoleScheduleExpirationTypeAfterDateTime)
+		expiration.EndDateTime = utils.String(endDateTime.(string))
+	}
+
+	properties := graph.UnifiedRoleEligibilityScheduleRequest{
+		Action:           utils.String(graph.RoleScheduleRequestActionAdminAssign),
+		DirectoryScopeId: utils.String(d.Get("directory_scope_id").(string)),
+		Justification:    utils.String(d.Get("justification").(string)),
+		PrincipalId:      utils.String(d.Get("principal_object_id").(string)),
+		RoleDefinitionId: utils.String(d.Get("role_definition_id").(string)),
+		ScheduleInfo: &graph.RoleScheduleInfo{
+			Expiration: &expiration,
+		},
+	}
+
+	request, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Directory Role Eligibility Schedule Request: %+v", err)
+	}
+
+	if request.ID == nil || *request.ID == "" {
+		return fmt.Errorf("Directory Role Eligibility Schedule Request ID is empty or nil")
+	}
+
+	d.SetId(*request.ID)
+
+	return directoryRoleEligibilityScheduleRequestResourceRead(d, meta)
+}
+
+func directoryRoleEligibilityScheduleRequestResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleEligibilityScheduleRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	request, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Directory Role Eligibility Schedule Request with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Directory Role Eligibility Schedule Request with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("role_definition_id", request.RoleDefinitionId)
+	d.Set("principal_object_id", request.PrincipalId)
+	d.Set("justification", request.Justification)
+	d.Set("directory_scope_id", request.DirectoryScopeId)
+	d.Set("status", request.Status)
+
+	if request.ScheduleInfo != nil {
+		d.Set("start_date_time", request.ScheduleInfo.StartDateTime)
+
+		if request.ScheduleInfo.Expiration != nil {
+			d.Set("expiration_date_time", request.ScheduleInfo.Expiration.EndDateTime)
+		}
+	}
+
+	return nil
+}
+
+func directoryRoleEligibilityScheduleRequestResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.RoleEligibilityScheduleRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	// eligibility schedule requests cannot be deleted directly; the eligibility is
+	// revoked by submitting an adminRemove request for the same principal and role
+	properties := graph.UnifiedRoleEligibilityScheduleRequest{
+		Action:           utils.String(graph.RoleScheduleRequestActionAdminRemove),
+		DirectoryScopeId: utils.String(d.Get("directory_scope_id").(string)),
+		Justification:    utils.String("Removed by Terraform"),
+		PrincipalId:      utils.String(d.Get("principal_object_id").(string)),
+		RoleDefinitionId: utils.String(d.Get("role_definition_id").(string)),
+	}
+
+	if _, _, err := client.Create(ctx, properties); err != nil {
+		return fmt.Errorf("revoking Directory Role Eligibility Schedule Request with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
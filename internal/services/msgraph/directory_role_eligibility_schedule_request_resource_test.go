@@ -0,0 +1,55 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccDirectoryRoleEligibilityScheduleRequest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_eligibility_schedule_request", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRoleEligibilityScheduleRequest_basic(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "role_definition_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "principal_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectoryRoleEligibilityScheduleRequest_basic(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Directory Readers"
+}
+
+resource "azuread_directory_role_eligibility_schedule_request" "test" {
+  role_definition_id  = azuread_directory_role.test.template_id
+  principal_object_id = azuread_user.test.id
+  justification       = "acceptance testing"
+}
+`, id, password)
+}
@@ -0,0 +1,126 @@
+package msgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+const directoryRoleMemberResourceName = "azuread_directory_role_member"
+
+func directoryRoleMemberResource() *schema.Resource {
+	return &schema.Resource{
+		Create: directoryRoleMemberResourceCreate,
+		Read:   directoryRoleMemberResourceRead,
+		Delete: directoryRoleMemberResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseDirectoryRoleMemberId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"role_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"member_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func directoryRoleMemberResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectoryRolesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	roleID := d.Get("role_object_id").(string)
+	memberID := d.Get("member_object_id").(string)
+
+	id := graph.DirectoryRoleMemberIdFrom(roleID, memberID)
+
+	tf.LockByName(directoryRoleMemberResourceName, roleID)
+	defer tf.UnlockByName(directoryRoleMemberResourceName, roleID)
+
+	existingMembers, err := client.ListMembers(ctx, roleID)
+	if err != nil {
+		return fmt.Errorf("listing existing members for Directory Role with ID %q: %+v", roleID, err)
+	}
+	for _, v := range existingMembers {
+		if strings.EqualFold(v, memberID) {
+			return tf.ImportAsExistsError(directoryRoleMemberResourceName, id.String())
+		}
+	}
+
+	if err := client.AddMember(ctx, roleID, memberID); err != nil {
+		return err
+	}
+
+	d.SetId(id.String())
+	return directoryRoleMemberResourceRead(d, meta)
+}
+
+func directoryRoleMemberResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectoryRolesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseDirectoryRoleMemberId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	members, err := client.ListMembers(ctx, id.RoleId)
+	if err != nil {
+		return fmt.Errorf("listing members for Directory Role with ID %q: %+v", id.RoleId, err)
+	}
+
+	var memberObjectID string
+	for _, objectID := range members {
+		if strings.EqualFold(objectID, id.MemberId) {
+			memberObjectID = objectID
+			break
+		}
+	}
+
+	if memberObjectID == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_object_id", id.RoleId)
+	d.Set("member_object_id", memberObjectID)
+
+	return nil
+}
+
+func directoryRoleMemberResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectoryRolesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseDirectoryRoleMemberId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	tf.LockByName(directoryRoleMemberResourceName, id.RoleId)
+	defer tf.UnlockByName(directoryRoleMemberResourceName, id.RoleId)
+
+	if err := client.RemoveMember(ctx, id.RoleId, id.MemberId); err != nil {
+		return err
+	}
+
+	return nil
+}
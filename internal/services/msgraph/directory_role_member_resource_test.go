@@ -0,0 +1,128 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccDirectoryRoleMember_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_member", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckDirectoryRoleMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRoleMember_user(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "role_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "member_object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccDirectoryRoleMember_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_member", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckDirectoryRoleMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRoleMember_servicePrincipal(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "role_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "member_object_id"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckDirectoryRoleMemberDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_directory_role_member" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.DirectoryRolesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseDirectoryRoleMemberId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		members, err := client.ListMembers(ctx, id.RoleId)
+		if err != nil {
+			return err
+		}
+
+		for _, objectID := range members {
+			if objectID == id.MemberId {
+				return fmt.Errorf("Directory Role membership still exists:\n%#v", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccDirectoryRoleMember_user(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Directory Readers"
+}
+
+resource "azuread_directory_role_member" "test" {
+  role_object_id   = azuread_directory_role.test.object_id
+  member_object_id = azuread_user.test.id
+}
+`, id, password)
+}
+
+func testAccDirectoryRoleMember_servicePrincipal(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_directory_role" "test" {
+  display_name = "Directory Readers"
+}
+
+resource "azuread_directory_role_member" "test" {
+  role_object_id   = azuread_directory_role.test.object_id
+  member_object_id = azuread_service_principal.test.id
+}
+`, id)
+}
@@ -0,0 +1,141 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func directoryRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: directoryRoleResourceCreate,
+		Read:   directoryRoleResourceRead,
+		Delete: directoryRoleResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"display_name", "template_id"},
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"display_name", "template_id"},
+				ValidateFunc: validate.UUID,
+			},
+
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func directoryRoleResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectoryRolesClient
+	templatesClient := meta.(*clients.AadClient).MsGraph.DirectoryRoleTemplatesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	templateId := d.Get("template_id").(string)
+
+	if templateId == "" {
+		displayName := d.Get("display_name").(string)
+
+		templates, err := templatesClient.List(ctx)
+		if err != nil {
+			return fmt.Errorf("listing Directory Role Templates: %+v", err)
+		}
+
+		for _, template := range templates {
+			if template.DisplayName != nil && strings.EqualFold(*template.DisplayName, displayName) && template.ID != nil {
+				templateId = *template.ID
+				break
+			}
+		}
+
+		if templateId == "" {
+			return fmt.Errorf("found no Directory Role Template with display name %q", displayName)
+		}
+	}
+
+	// activating an already-activated role returns a conflict, so check for an
+	// existing role first and adopt it when found
+	role, err := client.GetByTemplateId(ctx, templateId)
+	if err != nil {
+		return fmt.Errorf("listing Directory Roles: %+v", err)
+	}
+
+	if role == nil {
+		activatedRole, _, err := client.Activate(ctx, templateId)
+		if err != nil {
+			return fmt.Errorf("activating Directory Role for template ID %q: %+v", templateId, err)
+		}
+		role = activatedRole
+	}
+
+	if role.ID == nil || *role.ID == "" {
+		return fmt.Errorf("Directory Role ID is empty or nil")
+	}
+
+	d.SetId(*role.ID)
+
+	return directoryRoleResourceRead(d, meta)
+}
+
+func directoryRoleResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectoryRolesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	role, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Directory Role with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Directory Role with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("object_id", role.ID)
+	d.Set("display_name", role.DisplayName)
+	d.Set("description", role.Description)
+	d.Set("template_id", role.RoleTemplateId)
+
+	return nil
+}
+
+func directoryRoleResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	// directory roles cannot be deactivated through Microsoft Graph once enabled, so
+	// deletion simply removes the role from state
+	log.Printf("[DEBUG] Directory Role with ID %q cannot be deactivated - removing from state only", d.Id())
+	return nil
+}
@@ -0,0 +1,88 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccDirectoryRole_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRole_byDisplayName,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDirectoryRoleExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", "Helpdesk Administrator"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "template_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDirectoryRole_byTemplateId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRole_byTemplateId,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDirectoryRoleExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", "Directory Readers"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckDirectoryRoleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.DirectoryRolesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Directory Role %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Directory Role %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+const testAccDirectoryRole_byDisplayName = `
+resource "azuread_directory_role" "test" {
+  display_name = "Helpdesk Administrator"
+}
+`
+
+// 88d8e3e3-8f55-4a1e-953a-9b9898b8876b is the well-known template ID for the
+// Directory Readers role
+const testAccDirectoryRole_byTemplateId = `
+resource "azuread_directory_role" "test" {
+  template_id = "88d8e3e3-8f55-4a1e-953a-9b9898b8876b"
+}
+`
@@ -0,0 +1,85 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func directoryRoleTemplatesData() *schema.Resource {
+	return &schema.Resource{
+		Read: directoryRoleTemplatesDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"role_templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func directoryRoleTemplatesDataRead(d *schema.ResourceData, meta interface{}) error {
+	tenantId := meta.(*clients.AadClient).TenantID
+	client := meta.(*clients.AadClient).MsGraph.DirectoryRoleTemplatesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	result, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Directory Role Templates: %+v", err)
+	}
+
+	d.SetId("directoryRoleTemplates-" + tenantId)
+
+	if err = d.Set("role_templates", flattenDirectoryRoleTemplates(result)); err != nil {
+		return fmt.Errorf("setting `role_templates`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDirectoryRoleTemplates(input []graph.DirectoryRoleTemplate) []interface{} {
+	templates := make([]interface{}, 0)
+	for _, v := range input {
+		template := map[string]interface{}{
+			"object_id":    "",
+			"display_name": "",
+			"description":  "",
+		}
+
+		if v.ID != nil {
+			template["object_id"] = *v.ID
+		}
+		if v.DisplayName != nil {
+			template["display_name"] = *v.DisplayName
+		}
+		if v.Description != nil {
+			template["description"] = *v.Description
+		}
+
+		templates = append(templates, template)
+	}
+
+	return templates
+}
@@ -0,0 +1,31 @@
+package msgraph_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccDirectoryRoleTemplatesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_role_templates", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRoleTemplatesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "role_templates.0.object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "role_templates.0.display_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDirectoryRoleTemplatesDataSource_basic = `
+data "azuread_directory_role_templates" "test" {}
+`
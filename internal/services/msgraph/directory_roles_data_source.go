@@ -0,0 +1,94 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func directoryRolesData() *schema.Resource {
+	return &schema.Resource{
+		Read: directoryRolesDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"template_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func directoryRolesDataRead(d *schema.ResourceData, meta interface{}) error {
+	tenantId := meta.(*clients.AadClient).TenantID
+	client := meta.(*clients.AadClient).MsGraph.DirectoryRolesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	result, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Directory Roles: %+v", err)
+	}
+
+	d.SetId("directoryRoles-" + tenantId)
+
+	if err = d.Set("roles", flattenDirectoryRoles(result)); err != nil {
+		return fmt.Errorf("setting `roles`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDirectoryRoles(input []graph.DirectoryRole) []interface{} {
+	roles := make([]interface{}, 0)
+	for _, v := range input {
+		role := map[string]interface{}{
+			"object_id":    "",
+			"display_name": "",
+			"description":  "",
+			"template_id":  "",
+		}
+
+		if v.ID != nil {
+			role["object_id"] = *v.ID
+		}
+		if v.DisplayName != nil {
+			role["display_name"] = *v.DisplayName
+		}
+		if v.Description != nil {
+			role["description"] = *v.Description
+		}
+		if v.RoleTemplateId != nil {
+			role["template_id"] = *v.RoleTemplateId
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles
+}
@@ -0,0 +1,32 @@
+package msgraph_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccDirectoryRolesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_roles", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRolesDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "roles.0.object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "roles.0.display_name"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "roles.0.template_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDirectoryRolesDataSource_basic = `
+data "azuread_directory_roles" "test" {}
+`
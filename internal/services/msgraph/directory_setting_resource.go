@@ -0,0 +1,204 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func directorySettingResource() *schema.Resource {
+	return &schema.Resource{
+		Create: directorySettingResourceCreate,
+		Read:   directorySettingResourceRead,
+		Update: directorySettingResourceUpdate,
+		Delete: directorySettingResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseDirectorySettingId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"group_object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func directorySettingResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectorySettingsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	templateId := d.Get("template_id").(string)
+	groupId := d.Get("group_object_id").(string)
+
+	properties := graph.DirectorySetting{
+		TemplateId: utils.String(templateId),
+		Values:     expandDirectorySettingValues(d.Get("values").(*schema.Set).List()),
+	}
+
+	setting, _, err := client.Create(ctx, groupId, properties)
+	if err != nil {
+		return fmt.Errorf("creating Directory Setting from template %q: %+v", templateId, err)
+	}
+	if setting.ID == nil || *setting.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Directory Setting from template %q", templateId)
+	}
+
+	d.SetId(graph.DirectorySettingIdFrom(groupId, *setting.ID).String())
+
+	return directorySettingResourceRead(d, meta)
+}
+
+func directorySettingResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectorySettingsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseDirectorySettingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	properties := graph.DirectorySetting{
+		Values: expandDirectorySettingValues(d.Get("values").(*schema.Set).List()),
+	}
+
+	if _, err := client.Update(ctx, id.GroupId, id.SettingId, properties); err != nil {
+		return fmt.Errorf("updating Directory Setting with ID %q: %+v", d.Id(), err)
+	}
+
+	return directorySettingResourceRead(d, meta)
+}
+
+func directorySettingResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectorySettingsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseDirectorySettingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	setting, status, err := client.Get(ctx, id.GroupId, id.SettingId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Directory Setting with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Directory Setting with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("template_id", setting.TemplateId)
+	d.Set("group_object_id", id.GroupId)
+	d.Set("display_name", setting.DisplayName)
+
+	// the API returns every value defined by the setting template, so only track values
+	// named in the configuration to avoid diffs for unmanaged defaults
+	configuredNames := make(map[string]bool)
+	for _, raw := range d.Get("values").(*schema.Set).List() {
+		configuredNames[raw.(map[string]interface{})["name"].(string)] = true
+	}
+
+	values := make([]map[string]interface{}, 0)
+	if setting.Values != nil {
+		for _, v := range *setting.Values {
+			if v.Name == nil {
+				continue
+			}
+
+			if len(configuredNames) > 0 && !configuredNames[*v.Name] {
+				continue
+			}
+
+			value := ""
+			if v.Value != nil {
+				value = *v.Value
+			}
+
+			values = append(values, map[string]interface{}{
+				"name":  *v.Name,
+				"value": value,
+			})
+		}
+	}
+	d.Set("values", values)
+
+	return nil
+}
+
+func directorySettingResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.DirectorySettingsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseDirectorySettingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if status, err := client.Delete(ctx, id.GroupId, id.SettingId); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Directory Setting with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandDirectorySettingValues(in []interface{}) *[]graph.DirectorySettingValue {
+	result := make([]graph.DirectorySettingValue, 0, len(in))
+	for _, raw := range in {
+		value := raw.(map[string]interface{})
+		result = append(result, graph.DirectorySettingValue{
+			Name:  utils.String(value["name"].(string)),
+			Value: utils.String(value["value"].(string)),
+		})
+	}
+
+	return &result
+}
@@ -0,0 +1,113 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+// templateIdGroupUnified is the well-known ID of the Group.Unified directory setting template
+const templateIdGroupUnified = "62375ab9-6b52-47ed-826b-58e47e0e304b"
+
+func TestAccDirectorySetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckDirectorySettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySetting_basic("false"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDirectorySettingExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "template_id", templateIdGroupUnified),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "display_name"),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccDirectorySetting_basic("true"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDirectorySettingExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckDirectorySettingExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		id, err := graph.ParseDirectorySettingId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.DirectorySettingsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, id.GroupId, id.SettingId); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Directory Setting %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Directory Setting %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckDirectorySettingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_directory_setting" {
+			continue
+		}
+
+		id, err := graph.ParseDirectorySettingId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.DirectorySettingsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		setting, status, err := client.Get(ctx, id.GroupId, id.SettingId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Directory Setting still exists:\n%#v", setting)
+	}
+
+	return nil
+}
+
+func testAccDirectorySetting_basic(allowGuests string) string {
+	return fmt.Sprintf(`
+resource "azuread_directory_setting" "test" {
+  template_id = "%[1]s"
+
+  values {
+    name  = "AllowGuestsToAccessGroups"
+    value = "%[2]s"
+  }
+}
+`, templateIdGroupUnified, allowGuests)
+}
@@ -0,0 +1,342 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+const externalDomainFederationODataType = "#microsoft.graph.samlOrWsFedExternalDomainFederation"
+
+func externalIdentityProviderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: externalIdentityProviderResourceCreate,
+		Read:   externalIdentityProviderResourceRead,
+		Update: externalIdentityProviderResourceUpdate,
+		Delete: externalIdentityProviderResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the ID of the identity provider or federation configuration")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"social": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"social", "saml_or_ws_fed"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Facebook",
+								"Google",
+							}, false),
+						},
+
+						"client_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"client_secret": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"saml_or_ws_fed": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"social", "saml_or_ws_fed"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"issuer_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"passive_sign_in_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+
+						"signing_certificate": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"metadata_exchange_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+
+						"preferred_authentication_protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "wsFed",
+							ValidateFunc: validation.StringInSlice([]string{
+								"saml",
+								"wsFed",
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func externalIdentityProviderResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	if raw := d.Get("social").([]interface{}); len(raw) > 0 {
+		social := raw[0].(map[string]interface{})
+
+		identityProvider, _, err := client.Create(ctx, graph.IdentityProvider{
+			Name:         utils.String(displayName),
+			Type:         utils.String(social["type"].(string)),
+			ClientId:     utils.String(social["client_id"].(string)),
+			ClientSecret: utils.String(social["client_secret"].(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("creating External Identity Provider %q: %+v", displayName, err)
+		}
+		if identityProvider == nil || identityProvider.ID == nil || *identityProvider.ID == "" {
+			return fmt.Errorf("nil/blank ID returned for External Identity Provider %q", displayName)
+		}
+
+		d.SetId(*identityProvider.ID)
+	} else {
+		federation, _, err := client.CreateFederation(ctx, expandExternalDomainFederation(displayName, d.Get("saml_or_ws_fed").([]interface{})))
+		if err != nil {
+			return fmt.Errorf("creating External Identity Provider %q: %+v", displayName, err)
+		}
+		if federation == nil || federation.ID == nil || *federation.ID == "" {
+			return fmt.Errorf("nil/blank ID returned for External Identity Provider %q", displayName)
+		}
+
+		d.SetId(*federation.ID)
+	}
+
+	return externalIdentityProviderResourceRead(d, meta)
+}
+
+func externalIdentityProviderResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	if raw := d.Get("social").([]interface{}); len(raw) > 0 {
+		social := raw[0].(map[string]interface{})
+
+		properties := graph.IdentityProvider{
+			Name:     utils.String(displayName),
+			ClientId: utils.String(social["client_id"].(string)),
+		}
+
+		if d.HasChange("social.0.client_secret") {
+			properties.ClientSecret = utils.String(social["client_secret"].(string))
+		}
+
+		if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+			return fmt.Errorf("updating External Identity Provider %q: %+v", d.Id(), err)
+		}
+	} else {
+		properties := expandExternalDomainFederation(displayName, d.Get("saml_or_ws_fed").([]interface{}))
+
+		// the domain collection cannot be patched, and changes to it force a new resource
+		properties.Domains = nil
+
+		if _, err := client.UpdateFederation(ctx, d.Id(), properties); err != nil {
+			return fmt.Errorf("updating External Identity Provider %q: %+v", d.Id(), err)
+		}
+	}
+
+	return externalIdentityProviderResourceRead(d, meta)
+}
+
+func externalIdentityProviderResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	// social providers and domain federations have distinct ID namespaces, so try
+	// the social provider endpoint first and fall back to federation configurations
+	identityProvider, status, err := client.Get(ctx, d.Id())
+	if err == nil && identityProvider != nil {
+		d.Set("display_name", identityProvider.Name)
+
+		social := []interface{}{
+			map[string]interface{}{
+				"type":          "",
+				"client_id":     "",
+				"client_secret": d.Get("social.0.client_secret").(string), // the API masks the client secret
+			},
+		}
+		if identityProvider.Type != nil {
+			social[0].(map[string]interface{})["type"] = *identityProvider.Type
+		}
+		if identityProvider.ClientId != nil {
+			social[0].(map[string]interface{})["client_id"] = *identityProvider.ClientId
+		}
+		d.Set("social", social)
+		d.Set("saml_or_ws_fed", []interface{}{})
+
+		return nil
+	}
+	if status != http.StatusNotFound {
+		return fmt.Errorf("retrieving External Identity Provider %q: %+v", d.Id(), err)
+	}
+
+	federation, status, err := client.GetFederation(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] External Identity Provider %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving External Identity Provider %q: %+v", d.Id(), err)
+	}
+
+	d.Set("display_name", federation.DisplayName)
+	d.Set("social", []interface{}{})
+	d.Set("saml_or_ws_fed", flattenExternalDomainFederation(federation))
+
+	return nil
+}
+
+func externalIdentityProviderResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if len(d.Get("social").([]interface{})) > 0 {
+		if status, err := client.Delete(ctx, d.Id()); err != nil {
+			if status != http.StatusNotFound {
+				return fmt.Errorf("deleting External Identity Provider %q: %+v", d.Id(), err)
+			}
+		}
+	} else {
+		if status, err := client.DeleteFederation(ctx, d.Id()); err != nil {
+			if status != http.StatusNotFound {
+				return fmt.Errorf("deleting External Identity Provider %q: %+v", d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandExternalDomainFederation(displayName string, in []interface{}) graph.ExternalDomainFederation {
+	config := in[0].(map[string]interface{})
+
+	federation := graph.ExternalDomainFederation{
+		ODataType:          utils.String(externalDomainFederationODataType),
+		DisplayName:        utils.String(displayName),
+		IssuerUri:          utils.String(config["issuer_uri"].(string)),
+		PassiveSignInUri:   utils.String(config["passive_sign_in_uri"].(string)),
+		SigningCertificate: utils.String(config["signing_certificate"].(string)),
+		Domains: &[]graph.ExternalDomainName{
+			{
+				ODataType: utils.String("#microsoft.graph.externalDomainName"),
+				ID:        utils.String(config["domain_name"].(string)),
+			},
+		},
+	}
+
+	if v := config["metadata_exchange_uri"].(string); v != "" {
+		federation.MetadataExchangeUri = utils.String(v)
+	}
+
+	if v := config["preferred_authentication_protocol"].(string); v != "" {
+		federation.PreferredAuthenticationProtocol = utils.String(v)
+	}
+
+	return federation
+}
+
+func flattenExternalDomainFederation(federation *graph.ExternalDomainFederation) []interface{} {
+	domainName := ""
+	if federation.Domains != nil && len(*federation.Domains) > 0 && (*federation.Domains)[0].ID != nil {
+		domainName = *(*federation.Domains)[0].ID
+	}
+
+	issuerUri := ""
+	if federation.IssuerUri != nil {
+		issuerUri = *federation.IssuerUri
+	}
+
+	passiveSignInUri := ""
+	if federation.PassiveSignInUri != nil {
+		passiveSignInUri = *federation.PassiveSignInUri
+	}
+
+	signingCertificate := ""
+	if federation.SigningCertificate != nil {
+		signingCertificate = *federation.SigningCertificate
+	}
+
+	metadataExchangeUri := ""
+	if federation.MetadataExchangeUri != nil {
+		metadataExchangeUri = *federation.MetadataExchangeUri
+	}
+
+	preferredAuthenticationProtocol := ""
+	if federation.PreferredAuthenticationProtocol != nil {
+		preferredAuthenticationProtocol = *federation.PreferredAuthenticationProtocol
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"domain_name":                       domainName,
+			"issuer_uri":                        issuerUri,
+			"passive_sign_in_uri":               passiveSignInUri,
+			"signing_certificate":               signingCertificate,
+			"metadata_exchange_uri":             metadataExchangeUri,
+			"preferred_authentication_protocol": preferredAuthenticationProtocol,
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccExternalIdentityProvider_social(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_external_identity_provider", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckExternalIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExternalIdentityProvider_social(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckExternalIdentityProviderExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "social.0.type", "Google"),
+				),
+			},
+			data.ImportStep("social.0.client_secret"),
+		},
+	})
+}
+
+func testCheckExternalIdentityProviderExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.IdentityProvidersClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status != http.StatusNotFound {
+				return fmt.Errorf("retrieving External Identity Provider %q: %+v", rs.Primary.ID, err)
+			}
+
+			if _, status, err := client.GetFederation(ctx, rs.Primary.ID); err != nil {
+				if status == http.StatusNotFound {
+					return fmt.Errorf("External Identity Provider %q does not exist", rs.Primary.ID)
+				}
+				return fmt.Errorf("retrieving External Identity Provider %q: %+v", rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testCheckExternalIdentityProviderDestroy(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.IdentityProvidersClient
+	ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_external_identity_provider" {
+			continue
+		}
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err == nil {
+			return fmt.Errorf("External Identity Provider %q still exists", rs.Primary.ID)
+		} else if status != http.StatusNotFound {
+			return fmt.Errorf("retrieving External Identity Provider %q: %+v", rs.Primary.ID, err)
+		}
+
+		if _, status, err := client.GetFederation(ctx, rs.Primary.ID); err == nil {
+			return fmt.Errorf("External Identity Provider %q still exists", rs.Primary.ID)
+		} else if status != http.StatusNotFound {
+			return fmt.Errorf("retrieving External Identity Provider %q: %+v", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccExternalIdentityProvider_social(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_external_identity_provider" "test" {
+  display_name = "acctestIdP-%[1]d"
+
+  social {
+    type          = "Google"
+    client_id     = "0000000000000.apps.googleusercontent.com"
+    client_secret = "acctest-not-a-real-secret"
+  }
+}
+`, id)
+}
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type AccessReviewScope struct {
+	Query     *string `json:"query,omitempty"`
+	QueryType *string `json:"queryType,omitempty"`
+	QueryRoot *string `json:"queryRoot,omitempty"`
+}
+
+type AccessReviewRecurrencePattern struct {
+	Type     *string `json:"type,omitempty"`
+	Interval *int32  `json:"interval,omitempty"`
+}
+
+type AccessReviewRecurrenceRange struct {
+	Type      *string `json:"type,omitempty"`
+	StartDate *string `json:"startDate,omitempty"`
+	EndDate   *string `json:"endDate,omitempty"`
+}
+
+type AccessReviewRecurrence struct {
+	Pattern *AccessReviewRecurrencePattern `json:"pattern,omitempty"`
+	Range   *AccessReviewRecurrenceRange   `json:"range,omitempty"`
+}
+
+type AccessReviewScheduleSettings struct {
+	InstanceDurationInDays          *int32                  `json:"instanceDurationInDays,omitempty"`
+	AutoApplyDecisionsEnabled       *bool                   `json:"autoApplyDecisionsEnabled,omitempty"`
+	DefaultDecision                 *string                 `json:"defaultDecision,omitempty"`
+	DefaultDecisionEnabled          *bool                   `json:"defaultDecisionEnabled,omitempty"`
+	JustificationRequiredOnApproval *bool                   `json:"justificationRequiredOnApproval,omitempty"`
+	MailNotificationsEnabled        *bool                   `json:"mailNotificationsEnabled,omitempty"`
+	RecommendationsEnabled          *bool                   `json:"recommendationsEnabled,omitempty"`
+	ReminderNotificationsEnabled    *bool                   `json:"reminderNotificationsEnabled,omitempty"`
+	Recurrence                      *AccessReviewRecurrence `json:"recurrence,omitempty"`
+}
+
+type AccessReviewScheduleDefinition struct {
+	ID                      *string                       `json:"id,omitempty"`
+	DisplayName             *string                       `json:"displayName,omitempty"`
+	DescriptionForAdmins    *string                       `json:"descriptionForAdmins,omitempty"`
+	DescriptionForReviewers *string                       `json:"descriptionForReviewers,omitempty"`
+	Scope                   *AccessReviewScope            `json:"scope,omitempty"`
+	Reviewers               *[]AccessReviewScope          `json:"reviewers,omitempty"`
+	Settings                *AccessReviewScheduleSettings `json:"settings,omitempty"`
+	Status                  *string                       `json:"status,omitempty"`
+}
+
+type AccessReviewScheduleDefinitionsClient struct {
+	BaseClient
+}
+
+func NewAccessReviewScheduleDefinitionsClient(endpoint, tenantId string) AccessReviewScheduleDefinitionsClient {
+	return AccessReviewScheduleDefinitionsClient{
+		// access reviews are only available in the beta version of Microsoft Graph
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c AccessReviewScheduleDefinitionsClient) Get(ctx context.Context, id string) (*AccessReviewScheduleDefinition, int, error) {
+	var definition AccessReviewScheduleDefinition
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identityGovernance/accessReviews/definitions/%s", id), &definition)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &definition, status, nil
+}
+
+func (c AccessReviewScheduleDefinitionsClient) Create(ctx context.Context, definition AccessReviewScheduleDefinition) (*AccessReviewScheduleDefinition, int, error) {
+	var result AccessReviewScheduleDefinition
+	status, err := c.BaseClient.Post(ctx, "/identityGovernance/accessReviews/definitions", definition, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+// Update replaces the schedule definition using PUT, as the API does not support
+// patching individual properties of a definition.
+func (c AccessReviewScheduleDefinitionsClient) Update(ctx context.Context, id string, definition AccessReviewScheduleDefinition) (int, error) {
+	return c.BaseClient.Put(ctx, fmt.Sprintf("/identityGovernance/accessReviews/definitions/%s", id), definition)
+}
+
+func (c AccessReviewScheduleDefinitionsClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identityGovernance/accessReviews/definitions/%s", id))
+}
@@ -0,0 +1,43 @@
+package graph
+
+import (
+	"context"
+)
+
+type AccessReviewReviewerScope struct {
+	Query     *string `json:"query,omitempty"`
+	QueryRoot *string `json:"queryRoot,omitempty"`
+	QueryType *string `json:"queryType,omitempty"`
+}
+
+type AdminConsentRequestPolicy struct {
+	IsEnabled             *bool                        `json:"isEnabled,omitempty"`
+	NotifyReviewers       *bool                        `json:"notifyReviewers,omitempty"`
+	RemindersEnabled      *bool                        `json:"remindersEnabled,omitempty"`
+	RequestDurationInDays *int32                       `json:"requestDurationInDays,omitempty"`
+	Reviewers             *[]AccessReviewReviewerScope `json:"reviewers,omitempty"`
+	Version               *int32                       `json:"version,omitempty"`
+}
+
+type AdminConsentRequestPolicyClient struct {
+	BaseClient
+}
+
+func NewAdminConsentRequestPolicyClient(endpoint, tenantId string) AdminConsentRequestPolicyClient {
+	return AdminConsentRequestPolicyClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c AdminConsentRequestPolicyClient) Get(ctx context.Context) (*AdminConsentRequestPolicy, int, error) {
+	var policy AdminConsentRequestPolicy
+	status, err := c.BaseClient.Get(ctx, "/policies/adminConsentRequestPolicy", &policy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &policy, status, nil
+}
+
+func (c AdminConsentRequestPolicyClient) Update(ctx context.Context, policy AdminConsentRequestPolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, "/policies/adminConsentRequestPolicy", policy)
+}
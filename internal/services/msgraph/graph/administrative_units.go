@@ -0,0 +1,229 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	AdministrativeUnitVisibilityHiddenMembership = "HiddenMembership"
+	AdministrativeUnitVisibilityPublic           = "Public"
+)
+
+type AdministrativeUnit struct {
+	ID          *string `json:"id,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Visibility  *string `json:"visibility,omitempty"`
+}
+
+type AdministrativeUnitMemberId struct {
+	ObjectSubResourceId
+	AdministrativeUnitId string
+	MemberId             string
+}
+
+func AdministrativeUnitMemberIdFrom(administrativeUnitId, memberId string) AdministrativeUnitMemberId {
+	return AdministrativeUnitMemberId{
+		ObjectSubResourceId:  ObjectSubResourceIdFrom(administrativeUnitId, "member", memberId),
+		AdministrativeUnitId: administrativeUnitId,
+		MemberId:             memberId,
+	}
+}
+
+func ParseAdministrativeUnitMemberId(idString string) (*AdministrativeUnitMemberId, error) {
+	id, err := ParseObjectSubResourceId(idString, "member")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Member ID: %v", err)
+	}
+
+	return &AdministrativeUnitMemberId{
+		ObjectSubResourceId:  *id,
+		AdministrativeUnitId: id.objectId,
+		MemberId:             id.subId,
+	}, nil
+}
+
+type ScopedRoleMemberInfo struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type ScopedRoleMembership struct {
+	ID                   *string               `json:"id,omitempty"`
+	AdministrativeUnitId *string               `json:"administrativeUnitId,omitempty"`
+	RoleId               *string               `json:"roleId,omitempty"`
+	RoleMemberInfo       *ScopedRoleMemberInfo `json:"roleMemberInfo,omitempty"`
+}
+
+// ScopedRoleMembershipId addresses a scoped role membership within an Administrative
+// Unit. The membership ID issued by the API is opaque and not a UUID, so this does not
+// reuse ObjectSubResourceId.
+type ScopedRoleMembershipId struct {
+	AdministrativeUnitId string
+	ScopedRoleMemberId   string
+}
+
+func (id ScopedRoleMembershipId) String() string {
+	return fmt.Sprintf("%s/scopedRoleMember/%s", id.AdministrativeUnitId, id.ScopedRoleMemberId)
+}
+
+func ScopedRoleMembershipIdFrom(administrativeUnitId, scopedRoleMemberId string) ScopedRoleMembershipId {
+	return ScopedRoleMembershipId{
+		AdministrativeUnitId: administrativeUnitId,
+		ScopedRoleMemberId:   scopedRoleMemberId,
+	}
+}
+
+func ParseScopedRoleMembershipId(idString string) (*ScopedRoleMembershipId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 3 || parts[1] != "scopedRoleMember" {
+		return nil, fmt.Errorf("Scoped Role Membership ID should be in the format {administrativeUnitId}/scopedRoleMember/{id} - but got %q", idString)
+	}
+
+	if parts[0] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Administrative Unit ID and Scoped Role Membership ID should not be blank in %q", idString)
+	}
+
+	return &ScopedRoleMembershipId{
+		AdministrativeUnitId: parts[0],
+		ScopedRoleMemberId:   parts[2],
+	}, nil
+}
+
+type AdministrativeUnitsClient struct {
+	BaseClient
+}
+
+func NewAdministrativeUnitsClient(endpoint, tenantId string) AdministrativeUnitsClient {
+	return AdministrativeUnitsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c AdministrativeUnitsClient) List(ctx context.Context) ([]AdministrativeUnit, error) {
+	administrativeUnits := make([]AdministrativeUnit, 0)
+
+	path := "/directory/administrativeUnits"
+	for path != "" {
+		var result struct {
+			NextLink *string              `json:"@odata.nextLink"`
+			Value    []AdministrativeUnit `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Administrative Units: %+v", err)
+		}
+
+		administrativeUnits = append(administrativeUnits, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return administrativeUnits, nil
+}
+
+func (c AdministrativeUnitsClient) Get(ctx context.Context, id string) (*AdministrativeUnit, int, error) {
+	var administrativeUnit AdministrativeUnit
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/directory/administrativeUnits/%s", id), &administrativeUnit)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &administrativeUnit, status, nil
+}
+
+func (c AdministrativeUnitsClient) Create(ctx context.Context, administrativeUnit AdministrativeUnit) (*AdministrativeUnit, int, error) {
+	var result AdministrativeUnit
+	status, err := c.BaseClient.Post(ctx, "/directory/administrativeUnits", administrativeUnit, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AdministrativeUnitsClient) Update(ctx context.Context, id string, administrativeUnit AdministrativeUnit) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/directory/administrativeUnits/%s", id), administrativeUnit)
+}
+
+func (c AdministrativeUnitsClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/directory/administrativeUnits/%s", id))
+}
+
+// ListMembers returns the object IDs of the members of an Administrative Unit.
+func (c AdministrativeUnitsClient) ListMembers(ctx context.Context, id string) ([]string, error) {
+	members := make([]string, 0)
+
+	path := fmt.Sprintf("/directory/administrativeUnits/%s/members?$select=id", id)
+	for path != "" {
+		var result struct {
+			NextLink *string `json:"@odata.nextLink"`
+			Value    []struct {
+				ID string `json:"id"`
+			} `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing members for Administrative Unit with ID %q: %+v", id, err)
+		}
+
+		for _, v := range result.Value {
+			members = append(members, v.ID)
+		}
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return members, nil
+}
+
+func (c AdministrativeUnitsClient) AddMember(ctx context.Context, id, memberId string) error {
+	body := map[string]interface{}{
+		"@odata.id": fmt.Sprintf("%s/%s/directoryObjects/%s", strings.TrimSuffix(c.Endpoint, "/"), c.ApiVersion, memberId),
+	}
+
+	if _, err := c.BaseClient.Post(ctx, fmt.Sprintf("/directory/administrativeUnits/%s/members/$ref", id), body, nil); err != nil {
+		return fmt.Errorf("adding member %q to Administrative Unit with ID %q: %+v", memberId, id, err)
+	}
+
+	return nil
+}
+
+func (c AdministrativeUnitsClient) RemoveMember(ctx context.Context, id, memberId string) error {
+	if _, err := c.BaseClient.Delete(ctx, fmt.Sprintf("/directory/administrativeUnits/%s/members/%s/$ref", id, memberId)); err != nil {
+		return fmt.Errorf("removing member %q from Administrative Unit with ID %q: %+v", memberId, id, err)
+	}
+
+	return nil
+}
+
+func (c AdministrativeUnitsClient) GetScopedRoleMember(ctx context.Context, id, scopedRoleMemberId string) (*ScopedRoleMembership, int, error) {
+	var membership ScopedRoleMembership
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/directory/administrativeUnits/%s/scopedRoleMembers/%s", id, scopedRoleMemberId), &membership)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &membership, status, nil
+}
+
+func (c AdministrativeUnitsClient) AddScopedRoleMember(ctx context.Context, id string, membership ScopedRoleMembership) (*ScopedRoleMembership, int, error) {
+	var result ScopedRoleMembership
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/directory/administrativeUnits/%s/scopedRoleMembers", id), membership, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AdministrativeUnitsClient) RemoveScopedRoleMember(ctx context.Context, id, scopedRoleMemberId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/directory/administrativeUnits/%s/scopedRoleMembers/%s", id, scopedRoleMemberId))
+}
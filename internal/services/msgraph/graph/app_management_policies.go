@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	AppManagementRestrictionTypeAsymmetricKeyLifetime  = "asymmetricKeyLifetime"
+	AppManagementRestrictionTypeCustomPasswordAddition = "customPasswordAddition"
+	AppManagementRestrictionTypePasswordAddition       = "passwordAddition"
+	AppManagementRestrictionTypePasswordLifetime       = "passwordLifetime"
+	AppManagementRestrictionTypeSymmetricKeyAddition   = "symmetricKeyAddition"
+	AppManagementRestrictionTypeSymmetricKeyLifetime   = "symmetricKeyLifetime"
+)
+
+type CredentialRestriction struct {
+	RestrictionType                     *string `json:"restrictionType,omitempty"`
+	MaxLifetime                         *string `json:"maxLifetime,omitempty"`
+	RestrictForAppsCreatedAfterDateTime *string `json:"restrictForAppsCreatedAfterDateTime,omitempty"`
+}
+
+type AppManagementRestrictions struct {
+	KeyCredentials      *[]CredentialRestriction `json:"keyCredentials,omitempty"`
+	PasswordCredentials *[]CredentialRestriction `json:"passwordCredentials,omitempty"`
+}
+
+type AppManagementPolicy struct {
+	ID           *string                    `json:"id,omitempty"`
+	Description  *string                    `json:"description,omitempty"`
+	DisplayName  *string                    `json:"displayName,omitempty"`
+	IsEnabled    *bool                      `json:"isEnabled,omitempty"`
+	Restrictions *AppManagementRestrictions `json:"restrictions,omitempty"`
+}
+
+type TenantAppManagementPolicy struct {
+	Description                  *string                    `json:"description,omitempty"`
+	DisplayName                  *string                    `json:"displayName,omitempty"`
+	IsEnabled                    *bool                      `json:"isEnabled,omitempty"`
+	ApplicationRestrictions      *AppManagementRestrictions `json:"applicationRestrictions,omitempty"`
+	ServicePrincipalRestrictions *AppManagementRestrictions `json:"servicePrincipalRestrictions,omitempty"`
+}
+
+type AppManagementPoliciesClient struct {
+	BaseClient
+}
+
+func NewAppManagementPoliciesClient(endpoint, tenantId string) AppManagementPoliciesClient {
+	return AppManagementPoliciesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c AppManagementPoliciesClient) Create(ctx context.Context, policy AppManagementPolicy) (*AppManagementPolicy, int, error) {
+	var result AppManagementPolicy
+	status, err := c.BaseClient.Post(ctx, "/policies/appManagementPolicies", policy, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AppManagementPoliciesClient) Get(ctx context.Context, policyId string) (*AppManagementPolicy, int, error) {
+	var result AppManagementPolicy
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/policies/appManagementPolicies/%s", policyId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AppManagementPoliciesClient) Update(ctx context.Context, policyId string, policy AppManagementPolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/policies/appManagementPolicies/%s", policyId), policy)
+}
+
+func (c AppManagementPoliciesClient) Delete(ctx context.Context, policyId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/policies/appManagementPolicies/%s", policyId))
+}
+
+// GetTenantDefault returns the tenant-wide default app management policy, which is a
+// singleton that applies to all applications and service principals.
+func (c AppManagementPoliciesClient) GetTenantDefault(ctx context.Context) (*TenantAppManagementPolicy, int, error) {
+	var result TenantAppManagementPolicy
+	status, err := c.BaseClient.Get(ctx, "/policies/defaultAppManagementPolicy", &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AppManagementPoliciesClient) UpdateTenantDefault(ctx context.Context, policy TenantAppManagementPolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, "/policies/defaultAppManagementPolicy", policy)
+}
@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type AppRoleAssignment struct {
+	ID                   *string `json:"id,omitempty"`
+	AppRoleId            *string `json:"appRoleId,omitempty"`
+	PrincipalDisplayName *string `json:"principalDisplayName,omitempty"`
+	PrincipalId          *string `json:"principalId,omitempty"`
+	PrincipalType        *string `json:"principalType,omitempty"`
+	ResourceDisplayName  *string `json:"resourceDisplayName,omitempty"`
+	ResourceId           *string `json:"resourceId,omitempty"`
+}
+
+// AppRoleAssignmentId addresses an app role assignment on a resource service
+// principal. The assignment ID issued by the API is opaque and not a UUID.
+type AppRoleAssignmentId struct {
+	ResourceId   string
+	AssignmentId string
+}
+
+func (id AppRoleAssignmentId) String() string {
+	return fmt.Sprintf("%s/appRoleAssignment/%s", id.ResourceId, id.AssignmentId)
+}
+
+func AppRoleAssignmentIdFrom(resourceId, assignmentId string) AppRoleAssignmentId {
+	return AppRoleAssignmentId{
+		ResourceId:   resourceId,
+		AssignmentId: assignmentId,
+	}
+}
+
+func ParseAppRoleAssignmentId(idString string) (*AppRoleAssignmentId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 3 || parts[1] != "appRoleAssignment" {
+		return nil, fmt.Errorf("App Role Assignment ID should be in the format {resourceId}/appRoleAssignment/{id} - but got %q", idString)
+	}
+
+	if parts[0] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Resource ID and Assignment ID should not be blank in %q", idString)
+	}
+
+	return &AppRoleAssignmentId{
+		ResourceId:   parts[0],
+		AssignmentId: parts[2],
+	}, nil
+}
+
+type AppRoleAssignmentsClient struct {
+	BaseClient
+}
+
+func NewAppRoleAssignmentsClient(endpoint, tenantId string) AppRoleAssignmentsClient {
+	return AppRoleAssignmentsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+// ListAssignedTo returns the app role assignments granted for the given resource
+// service principal.
+func (c AppRoleAssignmentsClient) ListAssignedTo(ctx context.Context, resourceId string) ([]AppRoleAssignment, error) {
+	assignments := make([]AppRoleAssignment, 0)
+
+	path := fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo", resourceId)
+	for path != "" {
+		var result struct {
+			NextLink *string             `json:"@odata.nextLink"`
+			Value    []AppRoleAssignment `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing App Role Assignments for resource %q: %+v", resourceId, err)
+		}
+
+		assignments = append(assignments, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return assignments, nil
+}
+
+func (c AppRoleAssignmentsClient) Assign(ctx context.Context, resourceId string, assignment AppRoleAssignment) (*AppRoleAssignment, int, error) {
+	var result AppRoleAssignment
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo", resourceId), assignment, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AppRoleAssignmentsClient) Remove(ctx context.Context, resourceId, assignmentId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo/%s", resourceId, assignmentId))
+}
@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type ApplicationTemplateApplication struct {
+	ID          *string `json:"id,omitempty"`
+	AppId       *string `json:"appId,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+type ApplicationTemplateServicePrincipal struct {
+	ID          *string `json:"id,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+type ApplicationServicePrincipal struct {
+	Application      *ApplicationTemplateApplication      `json:"application,omitempty"`
+	ServicePrincipal *ApplicationTemplateServicePrincipal `json:"servicePrincipal,omitempty"`
+}
+
+type ApplicationFromTemplateId struct {
+	ObjectSubResourceId
+	ApplicationId      string
+	ServicePrincipalId string
+}
+
+func ApplicationFromTemplateIdFrom(applicationId, servicePrincipalId string) ApplicationFromTemplateId {
+	return ApplicationFromTemplateId{
+		ObjectSubResourceId: ObjectSubResourceIdFrom(applicationId, "servicePrincipal", servicePrincipalId),
+		ApplicationId:       applicationId,
+		ServicePrincipalId:  servicePrincipalId,
+	}
+}
+
+func ParseApplicationFromTemplateId(idString string) (*ApplicationFromTemplateId, error) {
+	id, err := ParseObjectSubResourceId(idString, "servicePrincipal")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Application From Template ID: %v", err)
+	}
+
+	return &ApplicationFromTemplateId{
+		ObjectSubResourceId: *id,
+		ApplicationId:       id.objectId,
+		ServicePrincipalId:  id.subId,
+	}, nil
+}
+
+type ApplicationTemplatesClient struct {
+	BaseClient
+}
+
+func NewApplicationTemplatesClient(endpoint, tenantId string) ApplicationTemplatesClient {
+	return ApplicationTemplatesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+// Instantiate creates an application and service principal from the given gallery
+// application template.
+func (c ApplicationTemplatesClient) Instantiate(ctx context.Context, templateId, displayName string) (*ApplicationServicePrincipal, int, error) {
+	body := struct {
+		DisplayName string `json:"displayName"`
+	}{
+		DisplayName: displayName,
+	}
+
+	var result ApplicationServicePrincipal
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/applicationTemplates/%s/instantiate", templateId), body, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
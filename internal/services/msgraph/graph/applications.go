@@ -0,0 +1,347 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type PreAuthorizedApplication struct {
+	AppId                  *string   `json:"appId,omitempty"`
+	DelegatedPermissionIds *[]string `json:"delegatedPermissionIds,omitempty"`
+}
+
+type PermissionScope struct {
+	ID                      *string `json:"id,omitempty"`
+	AdminConsentDescription *string `json:"adminConsentDescription,omitempty"`
+	AdminConsentDisplayName *string `json:"adminConsentDisplayName,omitempty"`
+	IsEnabled               *bool   `json:"isEnabled,omitempty"`
+	Type                    *string `json:"type,omitempty"`
+	UserConsentDescription  *string `json:"userConsentDescription,omitempty"`
+	UserConsentDisplayName  *string `json:"userConsentDisplayName,omitempty"`
+	Value                   *string `json:"value,omitempty"`
+}
+
+type ApplicationApi struct {
+	KnownClientApplications     *[]string                   `json:"knownClientApplications,omitempty"`
+	OAuth2PermissionScopes      *[]PermissionScope          `json:"oauth2PermissionScopes,omitempty"`
+	PreAuthorizedApplications   *[]PreAuthorizedApplication `json:"preAuthorizedApplications,omitempty"`
+	RequestedAccessTokenVersion *int32                      `json:"requestedAccessTokenVersion,omitempty"`
+}
+
+type ApplicationWeb struct {
+	RedirectUris *[]string `json:"redirectUris,omitempty"`
+}
+
+type ApplicationSpa struct {
+	RedirectUris *[]string `json:"redirectUris,omitempty"`
+}
+
+type ApplicationPublicClient struct {
+	RedirectUris *[]string `json:"redirectUris,omitempty"`
+}
+
+type VerifiedPublisher struct {
+	AddedDateTime       *string `json:"addedDateTime,omitempty"`
+	DisplayName         *string `json:"displayName,omitempty"`
+	VerifiedPublisherId *string `json:"verifiedPublisherId,omitempty"`
+}
+
+type Application struct {
+	ID                     *string                  `json:"id,omitempty"`
+	Api                    *ApplicationApi          `json:"api,omitempty"`
+	AppId                  *string                  `json:"appId,omitempty"`
+	DisplayName            *string                  `json:"displayName,omitempty"`
+	IsFallbackPublicClient *bool                    `json:"isFallbackPublicClient,omitempty"`
+	PublicClient           *ApplicationPublicClient `json:"publicClient,omitempty"`
+	Spa                    *ApplicationSpa          `json:"spa,omitempty"`
+	VerifiedPublisher      *VerifiedPublisher       `json:"verifiedPublisher,omitempty"`
+	Web                    *ApplicationWeb          `json:"web,omitempty"`
+}
+
+const (
+	ExtensionPropertyDataTypeBinary       = "Binary"
+	ExtensionPropertyDataTypeBoolean      = "Boolean"
+	ExtensionPropertyDataTypeDateTime     = "DateTime"
+	ExtensionPropertyDataTypeInteger      = "Integer"
+	ExtensionPropertyDataTypeLargeInteger = "LargeInteger"
+	ExtensionPropertyDataTypeString       = "String"
+)
+
+type ExtensionProperty struct {
+	ID            *string   `json:"id,omitempty"`
+	DataType      *string   `json:"dataType,omitempty"`
+	Name          *string   `json:"name,omitempty"`
+	TargetObjects *[]string `json:"targetObjects,omitempty"`
+}
+
+type FederatedIdentityCredential struct {
+	ID          *string   `json:"id,omitempty"`
+	Audiences   *[]string `json:"audiences,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Issuer      *string   `json:"issuer,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Subject     *string   `json:"subject,omitempty"`
+}
+
+const (
+	RedirectUriTypePublicClient = "public_client"
+	RedirectUriTypeSpa          = "spa"
+	RedirectUriTypeWeb          = "web"
+)
+
+// RedirectUrisId addresses the redirect URIs of one platform type on an application.
+// The platform type is not a UUID, so this does not reuse ObjectSubResourceId.
+type RedirectUrisId struct {
+	ObjectId string
+	UriType  string
+}
+
+func (id RedirectUrisId) String() string {
+	return fmt.Sprintf("%s/redirectUris/%s", id.ObjectId, id.UriType)
+}
+
+func RedirectUrisIdFrom(objectId, uriType string) RedirectUrisId {
+	return RedirectUrisId{
+		ObjectId: objectId,
+		UriType:  uriType,
+	}
+}
+
+func ParseRedirectUrisId(idString string) (*RedirectUrisId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 3 || parts[1] != "redirectUris" {
+		return nil, fmt.Errorf("Redirect URIs ID should be in the format {objectId}/redirectUris/{type} - but got %q", idString)
+	}
+
+	if _, err := uuid.ParseUUID(parts[0]); err != nil {
+		return nil, fmt.Errorf("Object ID isn't a valid UUID (%q): %+v", parts[0], err)
+	}
+
+	switch parts[2] {
+	case RedirectUriTypePublicClient, RedirectUriTypeSpa, RedirectUriTypeWeb:
+	default:
+		return nil, fmt.Errorf("Type in {objectId}/redirectUris/{type} should be one of %q, %q or %q - but got %q",
+			RedirectUriTypePublicClient, RedirectUriTypeSpa, RedirectUriTypeWeb, parts[2])
+	}
+
+	return &RedirectUrisId{
+		ObjectId: parts[0],
+		UriType:  parts[2],
+	}, nil
+}
+
+type PreAuthorizedApplicationId struct {
+	ObjectSubResourceId
+	ObjectId string
+	AppId    string
+}
+
+func PreAuthorizedApplicationIdFrom(objectId, appId string) PreAuthorizedApplicationId {
+	return PreAuthorizedApplicationId{
+		ObjectSubResourceId: ObjectSubResourceIdFrom(objectId, "preAuthorizedApplication", appId),
+		ObjectId:            objectId,
+		AppId:               appId,
+	}
+}
+
+func ParsePreAuthorizedApplicationId(idString string) (*PreAuthorizedApplicationId, error) {
+	id, err := ParseObjectSubResourceId(idString, "preAuthorizedApplication")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Pre-Authorized Application ID: %v", err)
+	}
+
+	return &PreAuthorizedApplicationId{
+		ObjectSubResourceId: *id,
+		ObjectId:            id.objectId,
+		AppId:               id.subId,
+	}, nil
+}
+
+type ExtensionPropertyId struct {
+	ObjectSubResourceId
+	ApplicationId string
+	PropertyId    string
+}
+
+func ExtensionPropertyIdFrom(applicationId, propertyId string) ExtensionPropertyId {
+	return ExtensionPropertyId{
+		ObjectSubResourceId: ObjectSubResourceIdFrom(applicationId, "extensionProperty", propertyId),
+		ApplicationId:       applicationId,
+		PropertyId:          propertyId,
+	}
+}
+
+func ParseExtensionPropertyId(idString string) (*ExtensionPropertyId, error) {
+	id, err := ParseObjectSubResourceId(idString, "extensionProperty")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Extension Property ID: %v", err)
+	}
+
+	return &ExtensionPropertyId{
+		ObjectSubResourceId: *id,
+		ApplicationId:       id.objectId,
+		PropertyId:          id.subId,
+	}, nil
+}
+
+type FederatedIdentityCredentialId struct {
+	ObjectSubResourceId
+	ApplicationId string
+	CredentialId  string
+}
+
+func FederatedIdentityCredentialIdFrom(applicationId, credentialId string) FederatedIdentityCredentialId {
+	return FederatedIdentityCredentialId{
+		ObjectSubResourceId: ObjectSubResourceIdFrom(applicationId, "federatedIdentityCredential", credentialId),
+		ApplicationId:       applicationId,
+		CredentialId:        credentialId,
+	}
+}
+
+func ParseFederatedIdentityCredentialId(idString string) (*FederatedIdentityCredentialId, error) {
+	id, err := ParseObjectSubResourceId(idString, "federatedIdentityCredential")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Federated Identity Credential ID: %v", err)
+	}
+
+	return &FederatedIdentityCredentialId{
+		ObjectSubResourceId: *id,
+		ApplicationId:       id.objectId,
+		CredentialId:        id.subId,
+	}, nil
+}
+
+type ApplicationsClient struct {
+	BaseClient
+}
+
+func NewApplicationsClient(endpoint, tenantId string) ApplicationsClient {
+	return ApplicationsClient{
+		// federated identity credentials are only exposed by the beta endpoint
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c ApplicationsClient) Get(ctx context.Context, applicationId string) (*Application, int, error) {
+	var result Application
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/applications/%s", applicationId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c ApplicationsClient) Delete(ctx context.Context, applicationId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/applications/%s", applicationId))
+}
+
+func (c ApplicationsClient) Update(ctx context.Context, applicationId string, application Application) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/applications/%s", applicationId), application)
+}
+
+func (c ApplicationsClient) SetLogo(ctx context.Context, applicationId, contentType string, data []byte) (int, error) {
+	return c.BaseClient.PutBinary(ctx, fmt.Sprintf("/applications/%s/logo", applicationId), contentType, data)
+}
+
+func (c ApplicationsClient) GetVerifiedPublisher(ctx context.Context, applicationId string) (*VerifiedPublisher, int, error) {
+	var result Application
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/applications/%s?$select=verifiedPublisher", applicationId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return result.VerifiedPublisher, status, nil
+}
+
+func (c ApplicationsClient) SetVerifiedPublisher(ctx context.Context, applicationId, verifiedPublisherId string) (int, error) {
+	body := map[string]interface{}{
+		"verifiedPublisherId": verifiedPublisherId,
+	}
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/applications/%s/setVerifiedPublisher", applicationId), body, nil)
+}
+
+func (c ApplicationsClient) UnsetVerifiedPublisher(ctx context.Context, applicationId string) (int, error) {
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/applications/%s/unsetVerifiedPublisher", applicationId), nil, nil)
+}
+
+func (c ApplicationsClient) ListExtensionProperties(ctx context.Context, applicationId string) ([]ExtensionProperty, int, error) {
+	var result struct {
+		Value []ExtensionProperty `json:"value"`
+	}
+
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/applications/%s/extensionProperties", applicationId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return result.Value, status, nil
+}
+
+func (c ApplicationsClient) GetExtensionProperty(ctx context.Context, applicationId, propertyId string) (*ExtensionProperty, int, error) {
+	var result ExtensionProperty
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/applications/%s/extensionProperties/%s", applicationId, propertyId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c ApplicationsClient) CreateExtensionProperty(ctx context.Context, applicationId string, property ExtensionProperty) (*ExtensionProperty, int, error) {
+	var result ExtensionProperty
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/applications/%s/extensionProperties", applicationId), property, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c ApplicationsClient) DeleteExtensionProperty(ctx context.Context, applicationId, propertyId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/applications/%s/extensionProperties/%s", applicationId, propertyId))
+}
+
+func (c ApplicationsClient) ListFederatedIdentityCredentials(ctx context.Context, applicationId string) ([]FederatedIdentityCredential, int, error) {
+	var result struct {
+		Value []FederatedIdentityCredential `json:"value"`
+	}
+
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/applications/%s/federatedIdentityCredentials", applicationId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return result.Value, status, nil
+}
+
+func (c ApplicationsClient) GetFederatedIdentityCredential(ctx context.Context, applicationId, credentialId string) (*FederatedIdentityCredential, int, error) {
+	var result FederatedIdentityCredential
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationId, credentialId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c ApplicationsClient) CreateFederatedIdentityCredential(ctx context.Context, applicationId string, credential FederatedIdentityCredential) (*FederatedIdentityCredential, int, error) {
+	var result FederatedIdentityCredential
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/applications/%s/federatedIdentityCredentials", applicationId), credential, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c ApplicationsClient) UpdateFederatedIdentityCredential(ctx context.Context, applicationId, credentialId string, credential FederatedIdentityCredential) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationId, credentialId), credential)
+}
+
+func (c ApplicationsClient) DeleteFederatedIdentityCredential(ctx context.Context, applicationId, credentialId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationId, credentialId))
+}
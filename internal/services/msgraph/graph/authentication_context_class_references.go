@@ -0,0 +1,43 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type AuthenticationContextClassReference struct {
+	ID          *string `json:"id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	IsAvailable *bool   `json:"isAvailable,omitempty"`
+}
+
+type AuthenticationContextClassReferencesClient struct {
+	BaseClient
+}
+
+func NewAuthenticationContextClassReferencesClient(endpoint, tenantId string) AuthenticationContextClassReferencesClient {
+	return AuthenticationContextClassReferencesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c AuthenticationContextClassReferencesClient) Get(ctx context.Context, id string) (*AuthenticationContextClassReference, int, error) {
+	var result AuthenticationContextClassReference
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identity/conditionalAccess/authenticationContextClassReferences/%s", id), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+// Update creates or updates the class reference with the given ID, since the c1-c25
+// identifiers are a fixed namespace rather than server-generated.
+func (c AuthenticationContextClassReferencesClient) Update(ctx context.Context, id string, reference AuthenticationContextClassReference) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/identity/conditionalAccess/authenticationContextClassReferences/%s", id), reference)
+}
+
+func (c AuthenticationContextClassReferencesClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identity/conditionalAccess/authenticationContextClassReferences/%s", id))
+}
@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"context"
+)
+
+type AuthenticationMethodsRegistrationCampaign struct {
+	SnoozeDurationInDays *int32  `json:"snoozeDurationInDays,omitempty"`
+	State                *string `json:"state,omitempty"`
+}
+
+type RegistrationEnforcement struct {
+	AuthenticationMethodsRegistrationCampaign *AuthenticationMethodsRegistrationCampaign `json:"authenticationMethodsRegistrationCampaign,omitempty"`
+}
+
+type ReportSuspiciousActivitySettings struct {
+	State              *string `json:"state,omitempty"`
+	VoiceReportingCode *int32  `json:"voiceReportingCode,omitempty"`
+}
+
+// AuthenticationMethodsPolicy is a tenant-wide singleton and cannot be created or deleted.
+type AuthenticationMethodsPolicy struct {
+	ID                               *string                           `json:"id,omitempty"`
+	RegistrationEnforcement          *RegistrationEnforcement          `json:"registrationEnforcement,omitempty"`
+	ReportSuspiciousActivitySettings *ReportSuspiciousActivitySettings `json:"reportSuspiciousActivitySettings,omitempty"`
+}
+
+type AuthenticationMethodsPolicyClient struct {
+	BaseClient
+}
+
+func NewAuthenticationMethodsPolicyClient(endpoint, tenantId string) AuthenticationMethodsPolicyClient {
+	return AuthenticationMethodsPolicyClient{
+		// reportSuspiciousActivitySettings is only exposed by the beta endpoint
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c AuthenticationMethodsPolicyClient) Get(ctx context.Context) (*AuthenticationMethodsPolicy, int, error) {
+	var result AuthenticationMethodsPolicy
+	status, err := c.BaseClient.Get(ctx, "/policies/authenticationMethodsPolicy", &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AuthenticationMethodsPolicyClient) Update(ctx context.Context, policy AuthenticationMethodsPolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, "/policies/authenticationMethodsPolicy", policy)
+}
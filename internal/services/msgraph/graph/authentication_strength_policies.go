@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type AuthenticationStrengthPolicy struct {
+	ID                  *string   `json:"id,omitempty"`
+	DisplayName         *string   `json:"displayName,omitempty"`
+	Description         *string   `json:"description,omitempty"`
+	AllowedCombinations *[]string `json:"allowedCombinations,omitempty"`
+}
+
+type AuthenticationStrengthPoliciesClient struct {
+	BaseClient
+}
+
+func NewAuthenticationStrengthPoliciesClient(endpoint, tenantId string) AuthenticationStrengthPoliciesClient {
+	return AuthenticationStrengthPoliciesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c AuthenticationStrengthPoliciesClient) Create(ctx context.Context, policy AuthenticationStrengthPolicy) (*AuthenticationStrengthPolicy, int, error) {
+	var result AuthenticationStrengthPolicy
+	status, err := c.BaseClient.Post(ctx, "/identity/conditionalAccess/authenticationStrength/policies", policy, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AuthenticationStrengthPoliciesClient) Get(ctx context.Context, policyId string) (*AuthenticationStrengthPolicy, int, error) {
+	var result AuthenticationStrengthPolicy
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identity/conditionalAccess/authenticationStrength/policies/%s", policyId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AuthenticationStrengthPoliciesClient) Update(ctx context.Context, policyId string, policy AuthenticationStrengthPolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/identity/conditionalAccess/authenticationStrength/policies/%s", policyId), policy)
+}
+
+// UpdateAllowedCombinations invokes the dedicated action for changing the allowed
+// combinations of a policy, which cannot be modified with a plain update.
+func (c AuthenticationStrengthPoliciesClient) UpdateAllowedCombinations(ctx context.Context, policyId string, allowedCombinations []string) (int, error) {
+	body := struct {
+		AllowedCombinations []string `json:"allowedCombinations"`
+	}{
+		AllowedCombinations: allowedCombinations,
+	}
+
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/identity/conditionalAccess/authenticationStrength/policies/%s/updateAllowedCombinations", policyId), body, nil)
+}
+
+func (c AuthenticationStrengthPoliciesClient) Delete(ctx context.Context, policyId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identity/conditionalAccess/authenticationStrength/policies/%s", policyId))
+}
@@ -0,0 +1,46 @@
+package graph
+
+import (
+	"context"
+)
+
+type DefaultUserRolePermissions struct {
+	AllowedToCreateApps             *bool     `json:"allowedToCreateApps,omitempty"`
+	AllowedToCreateSecurityGroups   *bool     `json:"allowedToCreateSecurityGroups,omitempty"`
+	AllowedToCreateTenants          *bool     `json:"allowedToCreateTenants,omitempty"`
+	AllowedToReadOtherUsers         *bool     `json:"allowedToReadOtherUsers,omitempty"`
+	PermissionGrantPoliciesAssigned *[]string `json:"permissionGrantPoliciesAssigned,omitempty"`
+}
+
+type AuthorizationPolicy struct {
+	AllowEmailVerifiedUsersToJoinOrganization *bool                       `json:"allowEmailVerifiedUsersToJoinOrganization,omitempty"`
+	AllowInvitesFrom                          *string                     `json:"allowInvitesFrom,omitempty"`
+	AllowedToSignUpEmailBasedSubscriptions    *bool                       `json:"allowedToSignUpEmailBasedSubscriptions,omitempty"`
+	AllowedToUseSSPR                          *bool                       `json:"allowedToUseSSPR,omitempty"`
+	BlockMsolPowerShell                       *bool                       `json:"blockMsolPowerShell,omitempty"`
+	DefaultUserRolePermissions                *DefaultUserRolePermissions `json:"defaultUserRolePermissions,omitempty"`
+	GuestUserRoleId                           *string                     `json:"guestUserRoleId,omitempty"`
+}
+
+type AuthorizationPolicyClient struct {
+	BaseClient
+}
+
+func NewAuthorizationPolicyClient(endpoint, tenantId string) AuthorizationPolicyClient {
+	return AuthorizationPolicyClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c AuthorizationPolicyClient) Get(ctx context.Context) (*AuthorizationPolicy, int, error) {
+	var policy AuthorizationPolicy
+	status, err := c.BaseClient.Get(ctx, "/policies/authorizationPolicy", &policy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &policy, status, nil
+}
+
+func (c AuthorizationPolicyClient) Update(ctx context.Context, policy AuthorizationPolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, "/policies/authorizationPolicy", policy)
+}
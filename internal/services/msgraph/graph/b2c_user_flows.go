@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type B2CUserFlow struct {
+	ID                  *string  `json:"id,omitempty"`
+	UserFlowType        *string  `json:"userFlowType,omitempty"`
+	UserFlowTypeVersion *float64 `json:"userFlowTypeVersion,omitempty"`
+}
+
+type UserFlowAttribute struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type B2CUserFlowAttributeAssignment struct {
+	ID                   *string            `json:"id,omitempty"`
+	IsOptional           *bool              `json:"isOptional,omitempty"`
+	RequiresVerification *bool              `json:"requiresVerification,omitempty"`
+	UserAttribute        *UserFlowAttribute `json:"userAttribute,omitempty"`
+}
+
+type B2CUserFlowsClient struct {
+	BaseClient
+}
+
+func NewB2CUserFlowsClient(endpoint, tenantId string) B2CUserFlowsClient {
+	return B2CUserFlowsClient{
+		// B2C user flows are only available in the beta version of Microsoft Graph
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c B2CUserFlowsClient) Get(ctx context.Context, userFlowId string) (*B2CUserFlow, int, error) {
+	var userFlow B2CUserFlow
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s", userFlowId), &userFlow)
+	if err != nil {
+		return nil, status, err
+	}
+	return &userFlow, status, nil
+}
+
+func (c B2CUserFlowsClient) Create(ctx context.Context, userFlow B2CUserFlow) (*B2CUserFlow, int, error) {
+	var result B2CUserFlow
+	status, err := c.BaseClient.Post(ctx, "/identity/b2cUserFlows", userFlow, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c B2CUserFlowsClient) Delete(ctx context.Context, userFlowId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s", userFlowId))
+}
+
+func (c B2CUserFlowsClient) ListIdentityProviders(ctx context.Context, userFlowId string) ([]string, int, error) {
+	var result struct {
+		Value []struct {
+			ID *string `json:"id"`
+		} `json:"value"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders", userFlowId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	providerIds := make([]string, 0)
+	for _, provider := range result.Value {
+		if provider.ID != nil {
+			providerIds = append(providerIds, *provider.ID)
+		}
+	}
+	return providerIds, status, nil
+}
+
+func (c B2CUserFlowsClient) AddIdentityProvider(ctx context.Context, userFlowId, identityProviderId string) (int, error) {
+	body := map[string]interface{}{
+		"@odata.id": fmt.Sprintf("%s/%s/identityProviders/%s", strings.TrimSuffix(c.Endpoint, "/"), c.ApiVersion, identityProviderId),
+	}
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders/$ref", userFlowId), body, nil)
+}
+
+func (c B2CUserFlowsClient) RemoveIdentityProvider(ctx context.Context, userFlowId, identityProviderId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders/%s/$ref", userFlowId, identityProviderId))
+}
+
+func (c B2CUserFlowsClient) ListAttributeAssignments(ctx context.Context, userFlowId string) ([]B2CUserFlowAttributeAssignment, int, error) {
+	var result struct {
+		Value []B2CUserFlowAttributeAssignment `json:"value"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments?$expand=userAttribute", userFlowId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return result.Value, status, nil
+}
+
+func (c B2CUserFlowsClient) AddAttributeAssignment(ctx context.Context, userFlowId, userAttributeId string, assignment B2CUserFlowAttributeAssignment) (int, error) {
+	body := map[string]interface{}{
+		"isOptional":               assignment.IsOptional,
+		"requiresVerification":     assignment.RequiresVerification,
+		"userAttribute@odata.bind": fmt.Sprintf("%s/%s/identity/userFlowAttributes/%s", strings.TrimSuffix(c.Endpoint, "/"), c.ApiVersion, userAttributeId),
+	}
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments", userFlowId), body, nil)
+}
+
+func (c B2CUserFlowsClient) UpdateAttributeAssignment(ctx context.Context, userFlowId, assignmentId string, assignment B2CUserFlowAttributeAssignment) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments/%s", userFlowId, assignmentId), assignment)
+}
+
+func (c B2CUserFlowsClient) RemoveAttributeAssignment(ctx context.Context, userFlowId, assignmentId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments/%s", userFlowId, assignmentId))
+}
@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// Version10 is the general availability version of Microsoft Graph
+	Version10 = "v1.0"
+
+	// VersionBeta is the beta version of Microsoft Graph, required for directory
+	// features which have not yet reached general availability
+	VersionBeta = "beta"
+)
+
+// BaseClient performs JSON requests against Microsoft Graph. The graphrbac SDK only
+// covers AAD Graph, so the clients in this package are written by hand on top of autorest.
+type BaseClient struct {
+	ApiVersion string
+	Endpoint   string
+	TenantId   string
+
+	autorest.Client
+}
+
+func NewBaseClient(endpoint, tenantId, apiVersion string) BaseClient {
+	return BaseClient{
+		ApiVersion: apiVersion,
+		Endpoint:   endpoint,
+		TenantId:   tenantId,
+		Client:     autorest.NewClientWithUserAgent(""),
+	}
+}
+
+// Get performs a GET request against the given relative path. An absolute URL is
+// accepted in place of a path, so that @odata.nextLink values can be followed when paging.
+func (c BaseClient) Get(ctx context.Context, path string, model interface{}) (int, error) {
+	return c.execute(ctx, http.MethodGet, path, nil, []int{http.StatusOK}, model)
+}
+
+func (c BaseClient) Post(ctx context.Context, path string, body interface{}, model interface{}) (int, error) {
+	return c.execute(ctx, http.MethodPost, path, body, []int{http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent}, model)
+}
+
+func (c BaseClient) Patch(ctx context.Context, path string, body interface{}) (int, error) {
+	return c.execute(ctx, http.MethodPatch, path, body, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}, nil)
+}
+
+func (c BaseClient) Put(ctx context.Context, path string, body interface{}) (int, error) {
+	return c.execute(ctx, http.MethodPut, path, body, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}, nil)
+}
+
+func (c BaseClient) Delete(ctx context.Context, path string) (int, error) {
+	return c.execute(ctx, http.MethodDelete, path, nil, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}, nil)
+}
+
+// PutBinary performs a PUT request with a raw payload and the given content type,
+// used for uploading images and other non-JSON content.
+func (c BaseClient) PutBinary(ctx context.Context, path, contentType string, data []byte) (int, error) {
+	uri := fmt.Sprintf("%s%s%s", strings.TrimSuffix(c.Endpoint, "/"), "/"+c.ApiVersion, path)
+
+	req, err := autorest.CreatePreparer(
+		autorest.WithBaseURL(uri),
+		autorest.AsContentType(contentType),
+		autorest.AsPut(),
+		autorest.WithBytes(&data),
+	).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("sending request: %+v", err)
+	}
+
+	status := resp.StatusCode
+	if !statusCodeWasExpected(status, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}) {
+		defer resp.Body.Close()
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return status, fmt.Errorf("unexpected status %d received with payload: %s", status, odataErrorFromResponseBody(respBody))
+	}
+
+	return status, autorest.Respond(resp, autorest.ByClosing())
+}
+
+func (c BaseClient) execute(ctx context.Context, method, path string, body interface{}, validStatusCodes []int, model interface{}) (int, error) {
+	uri := path
+	if !strings.HasPrefix(path, "http") {
+		uri = fmt.Sprintf("%s%s%s", strings.TrimSuffix(c.Endpoint, "/"), "/"+c.ApiVersion, path)
+	}
+
+	decorators := []autorest.PrepareDecorator{
+		autorest.WithBaseURL(uri),
+		autorest.AsContentType("application/json; charset=utf-8"),
+	}
+
+	switch method {
+	case http.MethodGet:
+		decorators = append(decorators, autorest.AsGet())
+	case http.MethodPost:
+		decorators = append(decorators, autorest.AsPost())
+	case http.MethodPatch:
+		decorators = append(decorators, autorest.AsPatch())
+	case http.MethodPut:
+		decorators = append(decorators, autorest.AsPut())
+	case http.MethodDelete:
+		decorators = append(decorators, autorest.AsDelete())
+	}
+
+	if body != nil {
+		decorators = append(decorators, autorest.WithJSON(body))
+	}
+
+	req, err := autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("sending request: %+v", err)
+	}
+
+	status := resp.StatusCode
+	if !statusCodeWasExpected(status, validStatusCodes) {
+		defer resp.Body.Close()
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return status, fmt.Errorf("unexpected status %d received with payload: %s", status, odataErrorFromResponseBody(respBody))
+	}
+
+	if model == nil {
+		return status, autorest.Respond(resp, autorest.ByClosing())
+	}
+
+	if err := autorest.Respond(resp, autorest.ByUnmarshallingJSON(model), autorest.ByClosing()); err != nil {
+		return status, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return status, nil
+}
+
+func statusCodeWasExpected(status int, expected []int) bool {
+	for _, v := range expected {
+		if status == v {
+			return true
+		}
+	}
+	return false
+}
+
+type odataError struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func odataErrorFromResponseBody(body []byte) string {
+	var e odataError
+	if err := json.Unmarshal(body, &e); err == nil && e.Error.Code != "" {
+		return fmt.Sprintf("%s: %s", e.Error.Code, e.Error.Message)
+	}
+
+	return string(body)
+}
@@ -0,0 +1,59 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type ConditionalAccessPolicy struct {
+	ID               *string `json:"id,omitempty"`
+	DisplayName      *string `json:"displayName,omitempty"`
+	State            *string `json:"state,omitempty"`
+	CreatedDateTime  *string `json:"createdDateTime,omitempty"`
+	ModifiedDateTime *string `json:"modifiedDateTime,omitempty"`
+}
+
+type ConditionalAccessPoliciesClient struct {
+	BaseClient
+}
+
+func NewConditionalAccessPoliciesClient(endpoint, tenantId string) ConditionalAccessPoliciesClient {
+	return ConditionalAccessPoliciesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c ConditionalAccessPoliciesClient) List(ctx context.Context) ([]ConditionalAccessPolicy, error) {
+	policies := make([]ConditionalAccessPolicy, 0)
+
+	path := "/identity/conditionalAccess/policies"
+	for path != "" {
+		var result struct {
+			NextLink *string                   `json:"@odata.nextLink"`
+			Value    []ConditionalAccessPolicy `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Conditional Access Policies: %+v", err)
+		}
+
+		policies = append(policies, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return policies, nil
+}
+
+func (c ConditionalAccessPoliciesClient) Get(ctx context.Context, id string) (*ConditionalAccessPolicy, int, error) {
+	var policy ConditionalAccessPolicy
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identity/conditionalAccess/policies/%s", id), &policy)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &policy, status, nil
+}
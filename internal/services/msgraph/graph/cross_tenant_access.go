@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type CrossTenantAccessPolicyTarget struct {
+	Target     *string `json:"target,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+}
+
+type CrossTenantAccessPolicyTargetConfiguration struct {
+	AccessType *string                          `json:"accessType,omitempty"`
+	Targets    *[]CrossTenantAccessPolicyTarget `json:"targets,omitempty"`
+}
+
+type CrossTenantAccessPolicyB2BSetting struct {
+	Applications   *CrossTenantAccessPolicyTargetConfiguration `json:"applications,omitempty"`
+	UsersAndGroups *CrossTenantAccessPolicyTargetConfiguration `json:"usersAndGroups,omitempty"`
+}
+
+type CrossTenantAccessPolicyInboundTrust struct {
+	IsCompliantDeviceAccepted           *bool `json:"isCompliantDeviceAccepted,omitempty"`
+	IsHybridAzureADJoinedDeviceAccepted *bool `json:"isHybridAzureADJoinedDeviceAccepted,omitempty"`
+	IsMfaAccepted                       *bool `json:"isMfaAccepted,omitempty"`
+}
+
+type CrossTenantAccessPolicyConfigurationDefault struct {
+	B2BCollaborationInbound  *CrossTenantAccessPolicyB2BSetting   `json:"b2bCollaborationInbound,omitempty"`
+	B2BCollaborationOutbound *CrossTenantAccessPolicyB2BSetting   `json:"b2bCollaborationOutbound,omitempty"`
+	InboundTrust             *CrossTenantAccessPolicyInboundTrust `json:"inboundTrust,omitempty"`
+	IsServiceDefault         *bool                                `json:"isServiceDefault,omitempty"`
+}
+
+type InboundOutboundPolicyConfiguration struct {
+	InboundAllowed  *bool `json:"inboundAllowed,omitempty"`
+	OutboundAllowed *bool `json:"outboundAllowed,omitempty"`
+}
+
+type CrossTenantAccessPolicyConfigurationPartner struct {
+	TenantId                     *string                              `json:"tenantId,omitempty"`
+	AutomaticUserConsentSettings *InboundOutboundPolicyConfiguration  `json:"automaticUserConsentSettings,omitempty"`
+	B2BCollaborationInbound      *CrossTenantAccessPolicyB2BSetting   `json:"b2bCollaborationInbound,omitempty"`
+	B2BCollaborationOutbound     *CrossTenantAccessPolicyB2BSetting   `json:"b2bCollaborationOutbound,omitempty"`
+	InboundTrust                 *CrossTenantAccessPolicyInboundTrust `json:"inboundTrust,omitempty"`
+}
+
+type CrossTenantAccessPolicyClient struct {
+	BaseClient
+}
+
+func NewCrossTenantAccessPolicyClient(endpoint string, tenantId string) CrossTenantAccessPolicyClient {
+	return CrossTenantAccessPolicyClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c CrossTenantAccessPolicyClient) GetDefault(ctx context.Context) (*CrossTenantAccessPolicyConfigurationDefault, int, error) {
+	var configuration CrossTenantAccessPolicyConfigurationDefault
+	status, err := c.BaseClient.Get(ctx, "/policies/crossTenantAccessPolicy/default", &configuration)
+	if err != nil {
+		return nil, status, err
+	}
+	return &configuration, status, nil
+}
+
+func (c CrossTenantAccessPolicyClient) UpdateDefault(ctx context.Context, configuration CrossTenantAccessPolicyConfigurationDefault) (int, error) {
+	return c.BaseClient.Patch(ctx, "/policies/crossTenantAccessPolicy/default", configuration)
+}
+
+// ResetDefault reverts the default configuration to the service defaults, discarding
+// any tenant customisations.
+func (c CrossTenantAccessPolicyClient) ResetDefault(ctx context.Context) (int, error) {
+	return c.BaseClient.Post(ctx, "/policies/crossTenantAccessPolicy/default/resetToSystemDefault", nil, nil)
+}
+
+func (c CrossTenantAccessPolicyClient) GetPartner(ctx context.Context, tenantId string) (*CrossTenantAccessPolicyConfigurationPartner, int, error) {
+	var partner CrossTenantAccessPolicyConfigurationPartner
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId), &partner)
+	if err != nil {
+		return nil, status, err
+	}
+	return &partner, status, nil
+}
+
+func (c CrossTenantAccessPolicyClient) CreatePartner(ctx context.Context, partner CrossTenantAccessPolicyConfigurationPartner) (*CrossTenantAccessPolicyConfigurationPartner, int, error) {
+	var result CrossTenantAccessPolicyConfigurationPartner
+	status, err := c.BaseClient.Post(ctx, "/policies/crossTenantAccessPolicy/partners", partner, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c CrossTenantAccessPolicyClient) UpdatePartner(ctx context.Context, tenantId string, partner CrossTenantAccessPolicyConfigurationPartner) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId), partner)
+}
+
+func (c CrossTenantAccessPolicyClient) DeletePartner(ctx context.Context, tenantId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId))
+}
@@ -0,0 +1,200 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	CustomSecurityAttributeStatusAvailable  = "Available"
+	CustomSecurityAttributeStatusDeprecated = "Deprecated"
+)
+
+type AttributeSet struct {
+	ID                  *string `json:"id,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	MaxAttributesPerSet *int32  `json:"maxAttributesPerSet,omitempty"`
+}
+
+type AllowedValue struct {
+	ID       *string `json:"id,omitempty"`
+	IsActive *bool   `json:"isActive,omitempty"`
+}
+
+type CustomSecurityAttributeDefinition struct {
+	ID                      *string         `json:"id,omitempty"`
+	AllowedValues           *[]AllowedValue `json:"allowedValues,omitempty"`
+	AttributeSet            *string         `json:"attributeSet,omitempty"`
+	Description             *string         `json:"description,omitempty"`
+	IsCollection            *bool           `json:"isCollection,omitempty"`
+	IsSearchable            *bool           `json:"isSearchable,omitempty"`
+	Name                    *string         `json:"name,omitempty"`
+	Status                  *string         `json:"status,omitempty"`
+	Type                    *string         `json:"type,omitempty"`
+	UsePreDefinedValuesOnly *bool           `json:"usePreDefinedValuesOnly,omitempty"`
+}
+
+// ParseCustomSecurityAttributeDefinitionId validates a definition ID, which the API
+// forms as {attributeSetName}_{attributeName}.
+func ParseCustomSecurityAttributeDefinitionId(idString string) error {
+	parts := strings.SplitN(idString, "_", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("Custom Security Attribute Definition ID should be in the format {attributeSetName}_{attributeName} - but got %q", idString)
+	}
+	return nil
+}
+
+// CustomSecurityAttributeAssignmentId addresses a custom security attribute value
+// assigned to a directory object, in the format {objectId}/{attributeSetName}_{attributeName}.
+type CustomSecurityAttributeAssignmentId struct {
+	ObjectId     string
+	DefinitionId string
+}
+
+func (id CustomSecurityAttributeAssignmentId) String() string {
+	return fmt.Sprintf("%s/%s", id.ObjectId, id.DefinitionId)
+}
+
+func CustomSecurityAttributeAssignmentIdFrom(objectId, definitionId string) CustomSecurityAttributeAssignmentId {
+	return CustomSecurityAttributeAssignmentId{
+		ObjectId:     objectId,
+		DefinitionId: definitionId,
+	}
+}
+
+func ParseCustomSecurityAttributeAssignmentId(idString string) (*CustomSecurityAttributeAssignmentId, error) {
+	parts := strings.SplitN(idString, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Custom Security Attribute Assignment ID should be in the format {objectId}/{definitionId} - but got %q", idString)
+	}
+
+	if _, err := uuid.ParseUUID(parts[0]); err != nil {
+		return nil, fmt.Errorf("Object ID isn't a valid UUID (%q): %+v", parts[0], err)
+	}
+
+	if err := ParseCustomSecurityAttributeDefinitionId(parts[1]); err != nil {
+		return nil, err
+	}
+
+	return &CustomSecurityAttributeAssignmentId{
+		ObjectId:     parts[0],
+		DefinitionId: parts[1],
+	}, nil
+}
+
+type CustomSecurityAttributesClient struct {
+	BaseClient
+}
+
+func NewCustomSecurityAttributesClient(endpoint, tenantId string) CustomSecurityAttributesClient {
+	return CustomSecurityAttributesClient{
+		// custom security attributes are only available in the beta version of Microsoft Graph
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c CustomSecurityAttributesClient) GetAttributeSet(ctx context.Context, attributeSetId string) (*AttributeSet, int, error) {
+	var attributeSet AttributeSet
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/directory/attributeSets/%s", attributeSetId), &attributeSet)
+	if err != nil {
+		return nil, status, err
+	}
+	return &attributeSet, status, nil
+}
+
+func (c CustomSecurityAttributesClient) CreateAttributeSet(ctx context.Context, attributeSet AttributeSet) (*AttributeSet, int, error) {
+	var result AttributeSet
+	status, err := c.BaseClient.Post(ctx, "/directory/attributeSets", attributeSet, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c CustomSecurityAttributesClient) UpdateAttributeSet(ctx context.Context, attributeSetId string, attributeSet AttributeSet) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/directory/attributeSets/%s", attributeSetId), attributeSet)
+}
+
+func (c CustomSecurityAttributesClient) GetDefinition(ctx context.Context, definitionId string) (*CustomSecurityAttributeDefinition, int, error) {
+	var definition CustomSecurityAttributeDefinition
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s", definitionId), &definition)
+	if err != nil {
+		return nil, status, err
+	}
+	return &definition, status, nil
+}
+
+func (c CustomSecurityAttributesClient) CreateDefinition(ctx context.Context, definition CustomSecurityAttributeDefinition) (*CustomSecurityAttributeDefinition, int, error) {
+	var result CustomSecurityAttributeDefinition
+	status, err := c.BaseClient.Post(ctx, "/directory/customSecurityAttributeDefinitions", definition, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c CustomSecurityAttributesClient) UpdateDefinition(ctx context.Context, definitionId string, definition CustomSecurityAttributeDefinition) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s", definitionId), definition)
+}
+
+func (c CustomSecurityAttributesClient) ListAllowedValues(ctx context.Context, definitionId string) ([]AllowedValue, int, error) {
+	var result struct {
+		Value []AllowedValue `json:"value"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s/allowedValues", definitionId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return result.Value, status, nil
+}
+
+func (c CustomSecurityAttributesClient) AddAllowedValue(ctx context.Context, definitionId string, value AllowedValue) (int, error) {
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s/allowedValues", definitionId), value, nil)
+}
+
+func (c CustomSecurityAttributesClient) UpdateAllowedValue(ctx context.Context, definitionId, valueId string, value AllowedValue) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s/allowedValues/%s", definitionId, valueId), value)
+}
+
+// GetUserAttributes returns the custom security attribute values assigned to a user,
+// keyed by attribute set name and then by attribute name.
+func (c CustomSecurityAttributesClient) GetUserAttributes(ctx context.Context, userId string) (map[string]map[string]interface{}, int, error) {
+	var result struct {
+		CustomSecurityAttributes map[string]map[string]interface{} `json:"customSecurityAttributes"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/users/%s?$select=customSecurityAttributes", userId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return result.CustomSecurityAttributes, status, nil
+}
+
+func (c CustomSecurityAttributesClient) SetUserAttributes(ctx context.Context, userId string, attributes map[string]interface{}) (int, error) {
+	body := map[string]interface{}{
+		"customSecurityAttributes": attributes,
+	}
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/users/%s", userId), body)
+}
+
+// GetServicePrincipalAttributes returns the custom security attribute values assigned
+// to a service principal, keyed by attribute set name and then by attribute name.
+func (c CustomSecurityAttributesClient) GetServicePrincipalAttributes(ctx context.Context, servicePrincipalId string) (map[string]map[string]interface{}, int, error) {
+	var result struct {
+		CustomSecurityAttributes map[string]map[string]interface{} `json:"customSecurityAttributes"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/servicePrincipals/%s?$select=customSecurityAttributes", servicePrincipalId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return result.CustomSecurityAttributes, status, nil
+}
+
+func (c CustomSecurityAttributesClient) SetServicePrincipalAttributes(ctx context.Context, servicePrincipalId string, attributes map[string]interface{}) (int, error) {
+	body := map[string]interface{}{
+		"customSecurityAttributes": attributes,
+	}
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/servicePrincipals/%s", servicePrincipalId), body)
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type DeletedItem struct {
+	ID              *string `json:"id,omitempty"`
+	DisplayName     *string `json:"displayName,omitempty"`
+	DeletedDateTime *string `json:"deletedDateTime,omitempty"`
+}
+
+type DeletedItemsClient struct {
+	BaseClient
+}
+
+func NewDeletedItemsClient(endpoint, tenantId string) DeletedItemsClient {
+	return DeletedItemsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+// List returns the soft-deleted directory objects of the given type, e.g.
+// microsoft.graph.application or microsoft.graph.group.
+func (c DeletedItemsClient) List(ctx context.Context, odataType string) ([]DeletedItem, error) {
+	items := make([]DeletedItem, 0)
+
+	path := fmt.Sprintf("/directory/deletedItems/%s", odataType)
+	for path != "" {
+		var result struct {
+			NextLink *string       `json:"@odata.nextLink"`
+			Value    []DeletedItem `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing deleted items of type %q: %+v", odataType, err)
+		}
+
+		items = append(items, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type Device struct {
+	ID                            *string            `json:"id,omitempty"`
+	AccountEnabled                *bool              `json:"accountEnabled,omitempty"`
+	ApproximateLastSignInDateTime *string            `json:"approximateLastSignInDateTime,omitempty"`
+	DeviceId                      *string            `json:"deviceId,omitempty"`
+	DisplayName                   *string            `json:"displayName,omitempty"`
+	ExtensionAttributes           *map[string]string `json:"extensionAttributes,omitempty"`
+	OperatingSystem               *string            `json:"operatingSystem,omitempty"`
+	OperatingSystemVersion        *string            `json:"operatingSystemVersion,omitempty"`
+	ProfileType                   *string            `json:"profileType,omitempty"`
+	TrustType                     *string            `json:"trustType,omitempty"`
+}
+
+type DevicesClient struct {
+	BaseClient
+}
+
+func NewDevicesClient(endpoint, tenantId string) DevicesClient {
+	return DevicesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c DevicesClient) Get(ctx context.Context, deviceId string) (*Device, int, error) {
+	var result Device
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/devices/%s", deviceId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+// List returns the devices matching the given OData filter, which may be empty.
+func (c DevicesClient) List(ctx context.Context, filter string) ([]Device, int, error) {
+	path := "/devices"
+	if filter != "" {
+		path = fmt.Sprintf("/devices?$filter=%s", url.QueryEscape(filter))
+	}
+
+	var result struct {
+		Value []Device `json:"value"`
+	}
+
+	status, err := c.BaseClient.Get(ctx, path, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return result.Value, status, nil
+}
+
+func (c DevicesClient) Update(ctx context.Context, deviceId string, device Device) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/devices/%s", deviceId), device)
+}
+
+func (c DevicesClient) Delete(ctx context.Context, deviceId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/devices/%s", deviceId))
+}
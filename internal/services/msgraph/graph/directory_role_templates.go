@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type DirectoryRoleTemplate struct {
+	ID          *string `json:"id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+type DirectoryRoleTemplatesClient struct {
+	BaseClient
+}
+
+func NewDirectoryRoleTemplatesClient(endpoint, tenantId string) DirectoryRoleTemplatesClient {
+	return DirectoryRoleTemplatesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+// List returns all directory role templates, including those for roles which have not
+// yet been activated in the tenant.
+func (c DirectoryRoleTemplatesClient) List(ctx context.Context) ([]DirectoryRoleTemplate, error) {
+	templates := make([]DirectoryRoleTemplate, 0)
+
+	path := "/directoryRoleTemplates"
+	for path != "" {
+		var result struct {
+			NextLink *string                 `json:"@odata.nextLink"`
+			Value    []DirectoryRoleTemplate `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Directory Role Templates: %+v", err)
+		}
+
+		templates = append(templates, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return templates, nil
+}
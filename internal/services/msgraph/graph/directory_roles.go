@@ -0,0 +1,171 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type DirectoryRole struct {
+	ID             *string `json:"id,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	DisplayName    *string `json:"displayName,omitempty"`
+	RoleTemplateId *string `json:"roleTemplateId,omitempty"`
+}
+
+type DirectoryRoleMemberId struct {
+	ObjectSubResourceId
+	RoleId   string
+	MemberId string
+}
+
+func DirectoryRoleMemberIdFrom(roleId, memberId string) DirectoryRoleMemberId {
+	return DirectoryRoleMemberId{
+		ObjectSubResourceId: ObjectSubResourceIdFrom(roleId, "member", memberId),
+		RoleId:              roleId,
+		MemberId:            memberId,
+	}
+}
+
+func ParseDirectoryRoleMemberId(idString string) (*DirectoryRoleMemberId, error) {
+	id, err := ParseObjectSubResourceId(idString, "member")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Member ID: %v", err)
+	}
+
+	return &DirectoryRoleMemberId{
+		ObjectSubResourceId: *id,
+		RoleId:              id.objectId,
+		MemberId:            id.subId,
+	}, nil
+}
+
+type DirectoryRolesClient struct {
+	BaseClient
+}
+
+func NewDirectoryRolesClient(endpoint, tenantId string) DirectoryRolesClient {
+	return DirectoryRolesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+// List returns all directory roles which have been activated in the tenant.
+func (c DirectoryRolesClient) List(ctx context.Context) ([]DirectoryRole, error) {
+	roles := make([]DirectoryRole, 0)
+
+	path := "/directoryRoles"
+	for path != "" {
+		var result struct {
+			NextLink *string         `json:"@odata.nextLink"`
+			Value    []DirectoryRole `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Directory Roles: %+v", err)
+		}
+
+		roles = append(roles, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return roles, nil
+}
+
+func (c DirectoryRolesClient) Get(ctx context.Context, objectId string) (*DirectoryRole, int, error) {
+	var role DirectoryRole
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/directoryRoles/%s", objectId), &role)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &role, status, nil
+}
+
+// GetByTemplateId returns the activated directory role for a role template, or a nil
+// role if the role has not been activated in the tenant.
+func (c DirectoryRolesClient) GetByTemplateId(ctx context.Context, templateId string) (*DirectoryRole, error) {
+	roles, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		if role.RoleTemplateId != nil && *role.RoleTemplateId == templateId {
+			return &role, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ListMembers returns the object IDs of the members of a directory role.
+func (c DirectoryRolesClient) ListMembers(ctx context.Context, id string) ([]string, error) {
+	members := make([]string, 0)
+
+	path := fmt.Sprintf("/directoryRoles/%s/members?$select=id", id)
+	for path != "" {
+		var result struct {
+			NextLink *string `json:"@odata.nextLink"`
+			Value    []struct {
+				ID string `json:"id"`
+			} `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing members for Directory Role with ID %q: %+v", id, err)
+		}
+
+		for _, v := range result.Value {
+			members = append(members, v.ID)
+		}
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return members, nil
+}
+
+func (c DirectoryRolesClient) AddMember(ctx context.Context, id, memberId string) error {
+	body := map[string]interface{}{
+		"@odata.id": fmt.Sprintf("%s/%s/directoryObjects/%s", strings.TrimSuffix(c.Endpoint, "/"), c.ApiVersion, memberId),
+	}
+
+	if _, err := c.BaseClient.Post(ctx, fmt.Sprintf("/directoryRoles/%s/members/$ref", id), body, nil); err != nil {
+		return fmt.Errorf("adding member %q to Directory Role with ID %q: %+v", memberId, id, err)
+	}
+
+	return nil
+}
+
+func (c DirectoryRolesClient) RemoveMember(ctx context.Context, id, memberId string) error {
+	if _, err := c.BaseClient.Delete(ctx, fmt.Sprintf("/directoryRoles/%s/members/%s/$ref", id, memberId)); err != nil {
+		return fmt.Errorf("removing member %q from Directory Role with ID %q: %+v", memberId, id, err)
+	}
+
+	return nil
+}
+
+// Activate enables a directory role in the tenant from its role template.
+func (c DirectoryRolesClient) Activate(ctx context.Context, roleTemplateId string) (*DirectoryRole, int, error) {
+	body := struct {
+		RoleTemplateId string `json:"roleTemplateId"`
+	}{
+		RoleTemplateId: roleTemplateId,
+	}
+
+	var role DirectoryRole
+	status, err := c.BaseClient.Post(ctx, "/directoryRoles", body, &role)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &role, status, nil
+}
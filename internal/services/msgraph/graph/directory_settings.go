@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type DirectorySettingValue struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type DirectorySetting struct {
+	ID          *string                  `json:"id,omitempty"`
+	DisplayName *string                  `json:"displayName,omitempty"`
+	TemplateId  *string                  `json:"templateId,omitempty"`
+	Values      *[]DirectorySettingValue `json:"values,omitempty"`
+}
+
+// DirectorySettingId addresses a directory setting. Tenant-level settings use the bare
+// setting ID, while group-scoped settings use the format {groupId}/setting/{settingId}.
+type DirectorySettingId struct {
+	GroupId   string
+	SettingId string
+}
+
+func (id DirectorySettingId) String() string {
+	if id.GroupId != "" {
+		return ObjectSubResourceIdFrom(id.GroupId, "setting", id.SettingId).String()
+	}
+
+	return id.SettingId
+}
+
+func DirectorySettingIdFrom(groupId, settingId string) DirectorySettingId {
+	return DirectorySettingId{
+		GroupId:   groupId,
+		SettingId: settingId,
+	}
+}
+
+func ParseDirectorySettingId(idString string) (*DirectorySettingId, error) {
+	if !strings.Contains(idString, "/") {
+		if _, err := uuid.ParseUUID(idString); err != nil {
+			return nil, fmt.Errorf("Directory Setting ID isn't a valid UUID (%q): %+v", idString, err)
+		}
+
+		return &DirectorySettingId{SettingId: idString}, nil
+	}
+
+	id, err := ParseObjectSubResourceId(idString, "setting")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Directory Setting ID: %v", err)
+	}
+
+	return &DirectorySettingId{
+		GroupId:   id.objectId,
+		SettingId: id.subId,
+	}, nil
+}
+
+type DirectorySettingsClient struct {
+	BaseClient
+}
+
+func NewDirectorySettingsClient(endpoint, tenantId string) DirectorySettingsClient {
+	return DirectorySettingsClient{
+		// directory settings are only exposed by the beta endpoint
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+// directorySettingsPath returns the settings collection path, scoped to the given
+// group when groupId is non-empty and to the tenant otherwise.
+func directorySettingsPath(groupId string) string {
+	if groupId != "" {
+		return fmt.Sprintf("/groups/%s/settings", groupId)
+	}
+
+	return "/settings"
+}
+
+func (c DirectorySettingsClient) Create(ctx context.Context, groupId string, setting DirectorySetting) (*DirectorySetting, int, error) {
+	var result DirectorySetting
+	status, err := c.BaseClient.Post(ctx, directorySettingsPath(groupId), setting, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c DirectorySettingsClient) Get(ctx context.Context, groupId, settingId string) (*DirectorySetting, int, error) {
+	var result DirectorySetting
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("%s/%s", directorySettingsPath(groupId), settingId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c DirectorySettingsClient) Update(ctx context.Context, groupId, settingId string, setting DirectorySetting) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("%s/%s", directorySettingsPath(groupId), settingId), setting)
+}
+
+func (c DirectorySettingsClient) Delete(ctx context.Context, groupId, settingId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("%s/%s", directorySettingsPath(groupId), settingId))
+}
@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type Domain struct {
+	ID                 *string `json:"id,omitempty"`
+	AuthenticationType *string `json:"authenticationType,omitempty"`
+	IsDefault          *bool   `json:"isDefault,omitempty"`
+	IsVerified         *bool   `json:"isVerified,omitempty"`
+}
+
+// DomainDnsRecord describes a DNS record used to prove ownership of a custom domain.
+// The Text, MailExchange and Preference fields are populated according to RecordType.
+type DomainDnsRecord struct {
+	ID               *string `json:"id,omitempty"`
+	IsOptional       *bool   `json:"isOptional,omitempty"`
+	Label            *string `json:"label,omitempty"`
+	RecordType       *string `json:"recordType,omitempty"`
+	SupportedService *string `json:"supportedService,omitempty"`
+	Ttl              *int32  `json:"ttl,omitempty"`
+	Text             *string `json:"text,omitempty"`
+	MailExchange     *string `json:"mailExchange,omitempty"`
+	Preference       *int32  `json:"preference,omitempty"`
+}
+
+type DomainsClient struct {
+	BaseClient
+}
+
+func NewDomainsClient(endpoint, tenantId string) DomainsClient {
+	return DomainsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c DomainsClient) Get(ctx context.Context, domainId string) (*Domain, int, error) {
+	var domain Domain
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/domains/%s", domainId), &domain)
+	if err != nil {
+		return nil, status, err
+	}
+	return &domain, status, nil
+}
+
+func (c DomainsClient) Create(ctx context.Context, domain Domain) (*Domain, int, error) {
+	var result Domain
+	status, err := c.BaseClient.Post(ctx, "/domains", domain, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c DomainsClient) Delete(ctx context.Context, domainId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/domains/%s", domainId))
+}
+
+func (c DomainsClient) ListVerificationDnsRecords(ctx context.Context, domainId string) ([]DomainDnsRecord, int, error) {
+	var result struct {
+		Value []DomainDnsRecord `json:"value"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/domains/%s/verificationDnsRecords", domainId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return result.Value, status, nil
+}
+
+// Verify asks the service to validate ownership of the domain by checking for the
+// verification DNS records, returning the updated domain on success.
+func (c DomainsClient) Verify(ctx context.Context, domainId string) (*Domain, int, error) {
+	var domain Domain
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/domains/%s/verify", domainId), nil, &domain)
+	if err != nil {
+		return nil, status, err
+	}
+	return &domain, status, nil
+}
@@ -0,0 +1,480 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type AccessPackage struct {
+	ID          *string `json:"id,omitempty"`
+	CatalogId   *string `json:"catalogId,omitempty"`
+	Description *string `json:"description,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	IsHidden    *bool   `json:"isHidden,omitempty"`
+}
+
+type AccessPackageCatalog struct {
+	ID                  *string `json:"id,omitempty"`
+	CatalogStatus       *string `json:"catalogStatus,omitempty"`
+	CatalogType         *string `json:"catalogType,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	DisplayName         *string `json:"displayName,omitempty"`
+	IsExternallyVisible *bool   `json:"isExternallyVisible,omitempty"`
+}
+
+// UserSet identifies a set of subjects acting as requestors, approvers or reviewers
+// in an assignment policy. The concrete subject type is carried in the odata type.
+type UserSet struct {
+	ODataType   *string `json:"@odata.type,omitempty"`
+	ID          *string `json:"id,omitempty"`
+	IsBackup    *bool   `json:"isBackup,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type RequestorSettings struct {
+	ScopeType         *string    `json:"scopeType,omitempty"`
+	AcceptRequests    *bool      `json:"acceptRequests,omitempty"`
+	AllowedRequestors *[]UserSet `json:"allowedRequestors,omitempty"`
+}
+
+type ApprovalStage struct {
+	ApprovalStageTimeOutInDays      *int32     `json:"approvalStageTimeOutInDays,omitempty"`
+	IsApproverJustificationRequired *bool      `json:"isApproverJustificationRequired,omitempty"`
+	IsEscalationEnabled             *bool      `json:"isEscalationEnabled,omitempty"`
+	EscalationTimeInMinutes         *int32     `json:"escalationTimeInMinutes,omitempty"`
+	PrimaryApprovers                *[]UserSet `json:"primaryApprovers,omitempty"`
+}
+
+type ApprovalSettings struct {
+	IsApprovalRequired               *bool            `json:"isApprovalRequired,omitempty"`
+	IsRequestorJustificationRequired *bool            `json:"isRequestorJustificationRequired,omitempty"`
+	ApprovalMode                     *string          `json:"approvalMode,omitempty"`
+	ApprovalStages                   *[]ApprovalStage `json:"approvalStages,omitempty"`
+}
+
+type AssignmentReviewSettings struct {
+	IsEnabled      *bool      `json:"isEnabled,omitempty"`
+	RecurrenceType *string    `json:"recurrenceType,omitempty"`
+	ReviewerType   *string    `json:"reviewerType,omitempty"`
+	DurationInDays *int32     `json:"durationInDays,omitempty"`
+	StartDateTime  *string    `json:"startDateTime,omitempty"`
+	Reviewers      *[]UserSet `json:"reviewers,omitempty"`
+}
+
+type AccessPackageAssignmentPolicy struct {
+	ID                      *string                   `json:"id,omitempty"`
+	AccessPackageId         *string                   `json:"accessPackageId,omitempty"`
+	DisplayName             *string                   `json:"displayName,omitempty"`
+	Description             *string                   `json:"description,omitempty"`
+	CanExtend               *bool                     `json:"canExtend,omitempty"`
+	DurationInDays          *int32                    `json:"durationInDays,omitempty"`
+	ExpirationDateTime      *string                   `json:"expirationDateTime,omitempty"`
+	RequestorSettings       *RequestorSettings        `json:"requestorSettings,omitempty"`
+	RequestApprovalSettings *ApprovalSettings         `json:"requestApprovalSettings,omitempty"`
+	AccessReviewSettings    *AssignmentReviewSettings `json:"accessReviewSettings,omitempty"`
+}
+
+type AccessPackagesClient struct {
+	BaseClient
+}
+
+func NewAccessPackagesClient(endpoint, tenantId string) AccessPackagesClient {
+	return AccessPackagesClient{
+		// entitlement management is only available in the beta version of Microsoft Graph
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c AccessPackagesClient) List(ctx context.Context) ([]AccessPackage, error) {
+	accessPackages := make([]AccessPackage, 0)
+
+	path := "/identityGovernance/entitlementManagement/accessPackages"
+	for path != "" {
+		var result struct {
+			NextLink *string         `json:"@odata.nextLink"`
+			Value    []AccessPackage `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Access Packages: %+v", err)
+		}
+
+		accessPackages = append(accessPackages, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return accessPackages, nil
+}
+
+func (c AccessPackagesClient) Get(ctx context.Context, id string) (*AccessPackage, int, error) {
+	var accessPackage AccessPackage
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s", id), &accessPackage)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &accessPackage, status, nil
+}
+
+func (c AccessPackagesClient) Create(ctx context.Context, accessPackage AccessPackage) (*AccessPackage, int, error) {
+	var result AccessPackage
+	status, err := c.BaseClient.Post(ctx, "/identityGovernance/entitlementManagement/accessPackages", accessPackage, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AccessPackagesClient) Update(ctx context.Context, id string, accessPackage AccessPackage) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s", id), accessPackage)
+}
+
+func (c AccessPackagesClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s", id))
+}
+
+type AccessPackageCatalogsClient struct {
+	BaseClient
+}
+
+func NewAccessPackageCatalogsClient(endpoint, tenantId string) AccessPackageCatalogsClient {
+	return AccessPackageCatalogsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c AccessPackageCatalogsClient) List(ctx context.Context) ([]AccessPackageCatalog, error) {
+	catalogs := make([]AccessPackageCatalog, 0)
+
+	path := "/identityGovernance/entitlementManagement/accessPackageCatalogs"
+	for path != "" {
+		var result struct {
+			NextLink *string                `json:"@odata.nextLink"`
+			Value    []AccessPackageCatalog `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Access Package Catalogs: %+v", err)
+		}
+
+		catalogs = append(catalogs, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return catalogs, nil
+}
+
+func (c AccessPackageCatalogsClient) Get(ctx context.Context, id string) (*AccessPackageCatalog, int, error) {
+	var catalog AccessPackageCatalog
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackageCatalogs/%s", id), &catalog)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &catalog, status, nil
+}
+
+func (c AccessPackageCatalogsClient) Create(ctx context.Context, catalog AccessPackageCatalog) (*AccessPackageCatalog, int, error) {
+	var result AccessPackageCatalog
+	status, err := c.BaseClient.Post(ctx, "/identityGovernance/entitlementManagement/accessPackageCatalogs", catalog, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AccessPackageCatalogsClient) Update(ctx context.Context, id string, catalog AccessPackageCatalog) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackageCatalogs/%s", id), catalog)
+}
+
+func (c AccessPackageCatalogsClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackageCatalogs/%s", id))
+}
+
+type AccessPackageAssignmentPoliciesClient struct {
+	BaseClient
+}
+
+func NewAccessPackageAssignmentPoliciesClient(endpoint, tenantId string) AccessPackageAssignmentPoliciesClient {
+	return AccessPackageAssignmentPoliciesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c AccessPackageAssignmentPoliciesClient) Get(ctx context.Context, id string) (*AccessPackageAssignmentPolicy, int, error) {
+	var policy AccessPackageAssignmentPolicy
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackageAssignmentPolicies/%s", id), &policy)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &policy, status, nil
+}
+
+func (c AccessPackageAssignmentPoliciesClient) Create(ctx context.Context, policy AccessPackageAssignmentPolicy) (*AccessPackageAssignmentPolicy, int, error) {
+	var result AccessPackageAssignmentPolicy
+	status, err := c.BaseClient.Post(ctx, "/identityGovernance/entitlementManagement/accessPackageAssignmentPolicies", policy, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+// Update replaces the assignment policy using PUT, as the API does not support
+// patching individual properties of an assignment policy.
+func (c AccessPackageAssignmentPoliciesClient) Update(ctx context.Context, id string, policy AccessPackageAssignmentPolicy) (int, error) {
+	return c.BaseClient.Put(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackageAssignmentPolicies/%s", id), policy)
+}
+
+func (c AccessPackageAssignmentPoliciesClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackageAssignmentPolicies/%s", id))
+}
+
+type AccessPackageResource struct {
+	ID           *string `json:"id,omitempty"`
+	DisplayName  *string `json:"displayName,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	OriginId     *string `json:"originId,omitempty"`
+	OriginSystem *string `json:"originSystem,omitempty"`
+	ResourceType *string `json:"resourceType,omitempty"`
+}
+
+type AccessPackageResourceRequest struct {
+	ID                    *string                `json:"id,omitempty"`
+	CatalogId             *string                `json:"catalogId,omitempty"`
+	RequestType           *string                `json:"requestType,omitempty"`
+	RequestState          *string                `json:"requestState,omitempty"`
+	AccessPackageResource *AccessPackageResource `json:"accessPackageResource,omitempty"`
+}
+
+// AccessPackageResourceCatalogAssociationId addresses a resource within a catalog. The
+// origin ID can be a URL for SharePoint resources, so this does not reuse ObjectSubResourceId.
+type AccessPackageResourceCatalogAssociationId struct {
+	CatalogId string
+	OriginId  string
+}
+
+func (id AccessPackageResourceCatalogAssociationId) String() string {
+	return fmt.Sprintf("%s/%s", id.CatalogId, id.OriginId)
+}
+
+func AccessPackageResourceCatalogAssociationIdFrom(catalogId, originId string) AccessPackageResourceCatalogAssociationId {
+	return AccessPackageResourceCatalogAssociationId{
+		CatalogId: catalogId,
+		OriginId:  originId,
+	}
+}
+
+func ParseAccessPackageResourceCatalogAssociationId(idString string) (*AccessPackageResourceCatalogAssociationId, error) {
+	parts := strings.SplitN(idString, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Catalog Association ID should be in the format {catalogId}/{originId} - but got %q", idString)
+	}
+
+	if _, err := uuid.ParseUUID(parts[0]); err != nil {
+		return nil, fmt.Errorf("Catalog ID isn't a valid UUID (%q): %+v", parts[0], err)
+	}
+
+	return &AccessPackageResourceCatalogAssociationId{
+		CatalogId: parts[0],
+		OriginId:  parts[1],
+	}, nil
+}
+
+// GetResource returns the resource with the given origin ID within the catalog, where a
+// status of http.StatusNotFound indicates that the resource is not part of the catalog.
+func (c AccessPackageCatalogsClient) GetResource(ctx context.Context, catalogId, originId string) (*AccessPackageResource, int, error) {
+	var result struct {
+		Value []AccessPackageResource `json:"value"`
+	}
+
+	path := fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackageCatalogs/%s/accessPackageResources?$filter=originId+eq+'%s'", catalogId, url.QueryEscape(originId))
+	status, err := c.BaseClient.Get(ctx, path, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	if len(result.Value) == 0 {
+		return nil, http.StatusNotFound, nil
+	}
+
+	return &result.Value[0], status, nil
+}
+
+type AccessPackageResourceRequestsClient struct {
+	BaseClient
+}
+
+func NewAccessPackageResourceRequestsClient(endpoint, tenantId string) AccessPackageResourceRequestsClient {
+	return AccessPackageResourceRequestsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c AccessPackageResourceRequestsClient) Create(ctx context.Context, request AccessPackageResourceRequest) (*AccessPackageResourceRequest, int, error) {
+	var result AccessPackageResourceRequest
+	status, err := c.BaseClient.Post(ctx, "/identityGovernance/entitlementManagement/accessPackageResourceRequests", request, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+type AccessPackageResourceRole struct {
+	ID                    *string                `json:"id,omitempty"`
+	DisplayName           *string                `json:"displayName,omitempty"`
+	OriginId              *string                `json:"originId,omitempty"`
+	OriginSystem          *string                `json:"originSystem,omitempty"`
+	AccessPackageResource *AccessPackageResource `json:"accessPackageResource,omitempty"`
+}
+
+type AccessPackageResourceScope struct {
+	ID           *string `json:"id,omitempty"`
+	OriginId     *string `json:"originId,omitempty"`
+	OriginSystem *string `json:"originSystem,omitempty"`
+}
+
+type AccessPackageResourceRoleScope struct {
+	ID                         *string                     `json:"id,omitempty"`
+	AccessPackageResourceRole  *AccessPackageResourceRole  `json:"accessPackageResourceRole,omitempty"`
+	AccessPackageResourceScope *AccessPackageResourceScope `json:"accessPackageResourceScope,omitempty"`
+}
+
+type AccessPackageResourceRoleScopeId struct {
+	ObjectSubResourceId
+	AccessPackageId string
+	RoleScopeId     string
+}
+
+func AccessPackageResourceRoleScopeIdFrom(accessPackageId, roleScopeId string) AccessPackageResourceRoleScopeId {
+	return AccessPackageResourceRoleScopeId{
+		ObjectSubResourceId: ObjectSubResourceIdFrom(accessPackageId, "roleScope", roleScopeId),
+		AccessPackageId:     accessPackageId,
+		RoleScopeId:         roleScopeId,
+	}
+}
+
+func ParseAccessPackageResourceRoleScopeId(idString string) (*AccessPackageResourceRoleScopeId, error) {
+	id, err := ParseObjectSubResourceId(idString, "roleScope")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Role Scope ID: %v", err)
+	}
+
+	return &AccessPackageResourceRoleScopeId{
+		ObjectSubResourceId: *id,
+		AccessPackageId:     id.objectId,
+		RoleScopeId:         id.subId,
+	}, nil
+}
+
+func (c AccessPackagesClient) CreateResourceRoleScope(ctx context.Context, accessPackageId string, roleScope AccessPackageResourceRoleScope) (*AccessPackageResourceRoleScope, int, error) {
+	var result AccessPackageResourceRoleScope
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s/accessPackageResourceRoleScopes", accessPackageId), roleScope, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c AccessPackagesClient) ListResourceRoleScopes(ctx context.Context, accessPackageId string) ([]AccessPackageResourceRoleScope, error) {
+	roleScopes := make([]AccessPackageResourceRoleScope, 0)
+
+	path := fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s/accessPackageResourceRoleScopes?$expand=accessPackageResourceRole,accessPackageResourceScope", accessPackageId)
+	for path != "" {
+		var result struct {
+			NextLink *string                          `json:"@odata.nextLink"`
+			Value    []AccessPackageResourceRoleScope `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Resource Role Scopes: %+v", err)
+		}
+
+		roleScopes = append(roleScopes, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return roleScopes, nil
+}
+
+func (c AccessPackagesClient) DeleteResourceRoleScope(ctx context.Context, accessPackageId, roleScopeId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s/accessPackageResourceRoleScopes/%s", accessPackageId, roleScopeId))
+}
+
+type ExpirationPattern struct {
+	EndDateTime *string `json:"endDateTime,omitempty"`
+	Type        *string `json:"type,omitempty"`
+}
+
+type RequestSchedule struct {
+	StartDateTime *string            `json:"startDateTime,omitempty"`
+	Expiration    *ExpirationPattern `json:"expiration,omitempty"`
+}
+
+type AccessPackageAssignment struct {
+	ID                 *string          `json:"id,omitempty"`
+	AccessPackageId    *string          `json:"accessPackageId,omitempty"`
+	AssignmentPolicyId *string          `json:"assignmentPolicyId,omitempty"`
+	TargetId           *string          `json:"targetId,omitempty"`
+	AssignmentState    *string          `json:"assignmentState,omitempty"`
+	Schedule           *RequestSchedule `json:"schedule,omitempty"`
+}
+
+type AccessPackageAssignmentRequest struct {
+	ID                      *string                  `json:"id,omitempty"`
+	RequestType             *string                  `json:"requestType,omitempty"`
+	RequestState            *string                  `json:"requestState,omitempty"`
+	AccessPackageAssignment *AccessPackageAssignment `json:"accessPackageAssignment,omitempty"`
+}
+
+type AccessPackageAssignmentRequestsClient struct {
+	BaseClient
+}
+
+func NewAccessPackageAssignmentRequestsClient(endpoint, tenantId string) AccessPackageAssignmentRequestsClient {
+	return AccessPackageAssignmentRequestsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c AccessPackageAssignmentRequestsClient) Get(ctx context.Context, id string) (*AccessPackageAssignmentRequest, int, error) {
+	var request AccessPackageAssignmentRequest
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackageAssignmentRequests/%s?$expand=accessPackageAssignment", id), &request)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &request, status, nil
+}
+
+func (c AccessPackageAssignmentRequestsClient) Create(ctx context.Context, request AccessPackageAssignmentRequest) (*AccessPackageAssignmentRequest, int, error) {
+	var result AccessPackageAssignmentRequest
+	status, err := c.BaseClient.Post(ctx, "/identityGovernance/entitlementManagement/accessPackageAssignmentRequests", request, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type GroupLifecyclePolicy struct {
+	ID                          *string `json:"id,omitempty"`
+	AlternateNotificationEmails *string `json:"alternateNotificationEmails,omitempty"`
+	GroupLifetimeInDays         *int32  `json:"groupLifetimeInDays,omitempty"`
+	ManagedGroupTypes           *string `json:"managedGroupTypes,omitempty"`
+}
+
+type GroupLifecyclePolicyGroupId struct {
+	PolicyId string
+	GroupId  string
+}
+
+func (id GroupLifecyclePolicyGroupId) String() string {
+	return id.PolicyId + "/" + id.GroupId
+}
+
+func GroupLifecyclePolicyGroupIdFrom(policyId, groupId string) GroupLifecyclePolicyGroupId {
+	return GroupLifecyclePolicyGroupId{
+		PolicyId: policyId,
+		GroupId:  groupId,
+	}
+}
+
+func ParseGroupLifecyclePolicyGroupId(idString string) (*GroupLifecyclePolicyGroupId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Group Lifecycle Policy Group ID should be in the format {policyId}/{groupId} - but got %q", idString)
+	}
+
+	for _, part := range parts {
+		if _, err := uuid.ParseUUID(part); err != nil {
+			return nil, fmt.Errorf("specified ID segment (%q) is not valid: %s", part, err)
+		}
+	}
+
+	return &GroupLifecyclePolicyGroupId{
+		PolicyId: parts[0],
+		GroupId:  parts[1],
+	}, nil
+}
+
+type GroupLifecyclePoliciesClient struct {
+	BaseClient
+}
+
+func NewGroupLifecyclePoliciesClient(endpoint, tenantId string) GroupLifecyclePoliciesClient {
+	return GroupLifecyclePoliciesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c GroupLifecyclePoliciesClient) Get(ctx context.Context, policyId string) (*GroupLifecyclePolicy, int, error) {
+	var policy GroupLifecyclePolicy
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/groupLifecyclePolicies/%s", policyId), &policy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &policy, status, nil
+}
+
+func (c GroupLifecyclePoliciesClient) Create(ctx context.Context, policy GroupLifecyclePolicy) (*GroupLifecyclePolicy, int, error) {
+	var result GroupLifecyclePolicy
+	status, err := c.BaseClient.Post(ctx, "/groupLifecyclePolicies", policy, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c GroupLifecyclePoliciesClient) Update(ctx context.Context, policyId string, policy GroupLifecyclePolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/groupLifecyclePolicies/%s", policyId), policy)
+}
+
+func (c GroupLifecyclePoliciesClient) Delete(ctx context.Context, policyId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/groupLifecyclePolicies/%s", policyId))
+}
+
+func (c GroupLifecyclePoliciesClient) AddGroup(ctx context.Context, policyId, groupId string) (int, error) {
+	body := map[string]interface{}{
+		"groupId": groupId,
+	}
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/groupLifecyclePolicies/%s/addGroup", policyId), body, nil)
+}
+
+func (c GroupLifecyclePoliciesClient) RemoveGroup(ctx context.Context, policyId, groupId string) (int, error) {
+	body := map[string]interface{}{
+		"groupId": groupId,
+	}
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/groupLifecyclePolicies/%s/removeGroup", policyId), body, nil)
+}
+
+// ListForGroup returns the lifecycle policies which apply to the given group
+func (c GroupLifecyclePoliciesClient) ListForGroup(ctx context.Context, groupId string) ([]GroupLifecyclePolicy, int, error) {
+	var result struct {
+		Value []GroupLifecyclePolicy `json:"value"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/groups/%s/groupLifecyclePolicies", groupId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return result.Value, status, nil
+}
@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	GroupTypeDynamicMembership = "DynamicMembership"
+	GroupTypeUnified           = "Unified"
+)
+
+const (
+	MembershipRuleProcessingStateOn     = "On"
+	MembershipRuleProcessingStatePaused = "Paused"
+)
+
+const (
+	GroupResourceBehaviorOptionAllowOnlyMembersToPost   = "AllowOnlyMembersToPost"
+	GroupResourceBehaviorOptionHideGroupInOutlook       = "HideGroupInOutlook"
+	GroupResourceBehaviorOptionSubscribeNewGroupMembers = "SubscribeNewGroupMembers"
+	GroupResourceBehaviorOptionWelcomeEmailDisabled     = "WelcomeEmailDisabled"
+	GroupResourceProvisioningOptionTeam                 = "Team"
+)
+
+const (
+	GroupVisibilityHiddenMembership = "Hiddenmembership"
+	GroupVisibilityPrivate          = "Private"
+	GroupVisibilityPublic           = "Public"
+)
+
+const (
+	OnPremisesGroupTypeUniversalDistributionGroup        = "UniversalDistributionGroup"
+	OnPremisesGroupTypeUniversalMailEnabledSecurityGroup = "UniversalMailEnabledSecurityGroup"
+	OnPremisesGroupTypeUniversalSecurityGroup            = "UniversalSecurityGroup"
+)
+
+type GroupWritebackConfiguration struct {
+	IsEnabled           *bool   `json:"isEnabled,omitempty"`
+	OnPremisesGroupType *string `json:"onPremisesGroupType,omitempty"`
+}
+
+type Group struct {
+	ID                            *string                      `json:"id,omitempty"`
+	Description                   *string                      `json:"description,omitempty"`
+	DisplayName                   *string                      `json:"displayName,omitempty"`
+	GroupTypes                    *[]string                    `json:"groupTypes,omitempty"`
+	MailEnabled                   *bool                        `json:"mailEnabled,omitempty"`
+	MailNickname                  *string                      `json:"mailNickname,omitempty"`
+	MembershipRule                *string                      `json:"membershipRule,omitempty"`
+	MembershipRuleProcessingState *string                      `json:"membershipRuleProcessingState,omitempty"`
+	ResourceBehaviorOptions       *[]string                    `json:"resourceBehaviorOptions,omitempty"`
+	ResourceProvisioningOptions   *[]string                    `json:"resourceProvisioningOptions,omitempty"`
+	SecurityEnabled               *bool                        `json:"securityEnabled,omitempty"`
+	Visibility                    *string                      `json:"visibility,omitempty"`
+	WritebackConfiguration        *GroupWritebackConfiguration `json:"writebackConfiguration,omitempty"`
+}
+
+type GroupPhoto struct {
+	ID     *string `json:"id,omitempty"`
+	Height *int32  `json:"height,omitempty"`
+	Width  *int32  `json:"width,omitempty"`
+}
+
+type GroupsClient struct {
+	BaseClient
+}
+
+func NewGroupsClient(endpoint, tenantId string) GroupsClient {
+	return GroupsClient{
+		// writeback configuration is only exposed by the beta endpoint
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c GroupsClient) Create(ctx context.Context, group Group) (*Group, int, error) {
+	var result Group
+	status, err := c.BaseClient.Post(ctx, "/groups", group, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c GroupsClient) Get(ctx context.Context, groupId string) (*Group, int, error) {
+	var result Group
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/groups/%s", groupId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c GroupsClient) Update(ctx context.Context, groupId string, group Group) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/groups/%s", groupId), group)
+}
+
+// GetPhoto returns the metadata for the photo of the given group. The photo
+// content itself is not returned; it cannot be read back once uploaded.
+func (c GroupsClient) GetPhoto(ctx context.Context, groupId string) (*GroupPhoto, int, error) {
+	var result GroupPhoto
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/groups/%s/photo", groupId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c GroupsClient) SetPhoto(ctx context.Context, groupId, contentType string, data []byte) (int, error) {
+	return c.BaseClient.PutBinary(ctx, fmt.Sprintf("/groups/%s/photo/$value", groupId), contentType, data)
+}
+
+func (c GroupsClient) DeletePhoto(ctx context.Context, groupId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/groups/%s/photo/$value", groupId))
+}
+
+// AddMembers adds the given object IDs as direct members of the group, batching
+// the additions since the API accepts at most 20 members@odata.bind entries per request.
+func (c GroupsClient) AddMembers(ctx context.Context, groupId string, memberIds []string) (int, error) {
+	const batchSize = 20
+
+	var status int
+	for len(memberIds) > 0 {
+		batch := memberIds
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		memberIds = memberIds[len(batch):]
+
+		bindings := make([]string, 0, len(batch))
+		for _, memberId := range batch {
+			bindings = append(bindings, fmt.Sprintf("%s/%s/directoryObjects/%s", strings.TrimSuffix(c.Endpoint, "/"), c.ApiVersion, memberId))
+		}
+
+		body := map[string]interface{}{
+			"members@odata.bind": bindings,
+		}
+
+		var err error
+		if status, err = c.BaseClient.Patch(ctx, fmt.Sprintf("/groups/%s", groupId), body); err != nil {
+			return status, err
+		}
+	}
+
+	return status, nil
+}
+
+func (c GroupsClient) RemoveMember(ctx context.Context, groupId, memberId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/groups/%s/members/%s/$ref", groupId, memberId))
+}
+
+// ListMembers returns the object IDs of all direct members of the given group,
+// following paging for large groups.
+func (c GroupsClient) ListMembers(ctx context.Context, groupId string) ([]string, int, error) {
+	members := make([]string, 0)
+
+	var status int
+	path := fmt.Sprintf("/groups/%s/members?$select=id", groupId)
+	for path != "" {
+		var result struct {
+			NextLink *string `json:"@odata.nextLink"`
+			Value    []struct {
+				ID *string `json:"id"`
+			} `json:"value"`
+		}
+
+		var err error
+		if status, err = c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, status, err
+		}
+
+		for _, v := range result.Value {
+			if v.ID != nil {
+				members = append(members, *v.ID)
+			}
+		}
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return members, status, nil
+}
+
+// ListTransitiveMembers returns the object IDs of all members of the given group,
+// including those inherited through nested group membership.
+func (c GroupsClient) ListTransitiveMembers(ctx context.Context, groupId string) ([]string, error) {
+	members := make([]string, 0)
+
+	path := fmt.Sprintf("/groups/%s/transitiveMembers?$select=id", groupId)
+	for path != "" {
+		var result struct {
+			NextLink *string `json:"@odata.nextLink"`
+			Value    []struct {
+				ID *string `json:"id"`
+			} `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing transitive members for Group with ID %q: %+v", groupId, err)
+		}
+
+		for _, v := range result.Value {
+			if v.ID != nil {
+				members = append(members, *v.ID)
+			}
+		}
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return members, nil
+}
@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type IdentityProvider struct {
+	ID           *string `json:"id,omitempty"`
+	ClientId     *string `json:"clientId,omitempty"`
+	ClientSecret *string `json:"clientSecret,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Type         *string `json:"type,omitempty"`
+}
+
+type IdentityProvidersClient struct {
+	BaseClient
+}
+
+func NewIdentityProvidersClient(endpoint, tenantId string) IdentityProvidersClient {
+	return IdentityProvidersClient{
+		// the identity provider API used by B2C user flows is only available in the
+		// beta version of Microsoft Graph
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c IdentityProvidersClient) Get(ctx context.Context, identityProviderId string) (*IdentityProvider, int, error) {
+	var identityProvider IdentityProvider
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identity/identityProviders/%s", identityProviderId), &identityProvider)
+	if err != nil {
+		return nil, status, err
+	}
+	return &identityProvider, status, nil
+}
+
+func (c IdentityProvidersClient) Create(ctx context.Context, identityProvider IdentityProvider) (*IdentityProvider, int, error) {
+	var result IdentityProvider
+	status, err := c.BaseClient.Post(ctx, "/identity/identityProviders", identityProvider, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c IdentityProvidersClient) Update(ctx context.Context, identityProviderId string, identityProvider IdentityProvider) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/identity/identityProviders/%s", identityProviderId), identityProvider)
+}
+
+func (c IdentityProvidersClient) Delete(ctx context.Context, identityProviderId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identity/identityProviders/%s", identityProviderId))
+}
+
+type ExternalDomainName struct {
+	ODataType *string `json:"@odata.type,omitempty"`
+	ID        *string `json:"id,omitempty"`
+}
+
+// ExternalDomainFederation describes a SAML or WS-Fed direct federation with a
+// partner domain, used for guest sign-in.
+type ExternalDomainFederation struct {
+	ODataType                       *string               `json:"@odata.type,omitempty"`
+	ID                              *string               `json:"id,omitempty"`
+	DisplayName                     *string               `json:"displayName,omitempty"`
+	Domains                         *[]ExternalDomainName `json:"domains,omitempty"`
+	IssuerUri                       *string               `json:"issuerUri,omitempty"`
+	MetadataExchangeUri             *string               `json:"metadataExchangeUri,omitempty"`
+	PassiveSignInUri                *string               `json:"passiveSignInUri,omitempty"`
+	PreferredAuthenticationProtocol *string               `json:"preferredAuthenticationProtocol,omitempty"`
+	SigningCertificate              *string               `json:"signingCertificate,omitempty"`
+}
+
+func (c IdentityProvidersClient) GetFederation(ctx context.Context, federationId string) (*ExternalDomainFederation, int, error) {
+	var federation ExternalDomainFederation
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/directory/federationConfigurations/%s", federationId), &federation)
+	if err != nil {
+		return nil, status, err
+	}
+	return &federation, status, nil
+}
+
+func (c IdentityProvidersClient) CreateFederation(ctx context.Context, federation ExternalDomainFederation) (*ExternalDomainFederation, int, error) {
+	var result ExternalDomainFederation
+	status, err := c.BaseClient.Post(ctx, "/directory/federationConfigurations", federation, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c IdentityProvidersClient) UpdateFederation(ctx context.Context, federationId string, federation ExternalDomainFederation) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/directory/federationConfigurations/%s", federationId), federation)
+}
+
+func (c IdentityProvidersClient) DeleteFederation(ctx context.Context, federationId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/directory/federationConfigurations/%s", federationId))
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"context"
+)
+
+type InvitedUser struct {
+	ID *string `json:"id,omitempty"`
+}
+
+type InvitedUserMessageInfo struct {
+	CustomizedMessageBody *string `json:"customizedMessageBody,omitempty"`
+	MessageLanguage       *string `json:"messageLanguage,omitempty"`
+}
+
+type Invitation struct {
+	ID                      *string                 `json:"id,omitempty"`
+	InvitedUser             *InvitedUser            `json:"invitedUser,omitempty"`
+	InvitedUserDisplayName  *string                 `json:"invitedUserDisplayName,omitempty"`
+	InvitedUserEmailAddress *string                 `json:"invitedUserEmailAddress,omitempty"`
+	InvitedUserMessageInfo  *InvitedUserMessageInfo `json:"invitedUserMessageInfo,omitempty"`
+	InvitedUserType         *string                 `json:"invitedUserType,omitempty"`
+	InviteRedeemUrl         *string                 `json:"inviteRedeemUrl,omitempty"`
+	InviteRedirectUrl       *string                 `json:"inviteRedirectUrl,omitempty"`
+	SendInvitationMessage   *bool                   `json:"sendInvitationMessage,omitempty"`
+	Status                  *string                 `json:"status,omitempty"`
+}
+
+type InvitationsClient struct {
+	BaseClient
+}
+
+func NewInvitationsClient(endpoint, tenantId string) InvitationsClient {
+	return InvitationsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+// Create submits an invitation. Invitations cannot be retrieved once created, so no Get
+// method is provided; callers should inspect the invited user instead.
+func (c InvitationsClient) Create(ctx context.Context, invitation Invitation) (*Invitation, int, error) {
+	var result Invitation
+	status, err := c.BaseClient.Post(ctx, "/invitations", invitation, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
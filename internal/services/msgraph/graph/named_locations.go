@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	ODataTypeCountryNamedLocation = "#microsoft.graph.countryNamedLocation"
+	ODataTypeIPNamedLocation      = "#microsoft.graph.ipNamedLocation"
+)
+
+type IPNamedLocationIPRange struct {
+	ODataType   *string `json:"@odata.type,omitempty"`
+	CIDRAddress *string `json:"cidrAddress,omitempty"`
+}
+
+// NamedLocation describes both ipNamedLocation and countryNamedLocation objects, which
+// share the same endpoint and are distinguished by their @odata.type.
+type NamedLocation struct {
+	ODataType   *string `json:"@odata.type,omitempty"`
+	ID          *string `json:"id,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// ipNamedLocation
+	IPRanges  *[]IPNamedLocationIPRange `json:"ipRanges,omitempty"`
+	IsTrusted *bool                     `json:"isTrusted,omitempty"`
+
+	// countryNamedLocation
+	CountriesAndRegions               *[]string `json:"countriesAndRegions,omitempty"`
+	IncludeUnknownCountriesAndRegions *bool     `json:"includeUnknownCountriesAndRegions,omitempty"`
+}
+
+type NamedLocationsClient struct {
+	BaseClient
+}
+
+func NewNamedLocationsClient(endpoint, tenantId string) NamedLocationsClient {
+	return NamedLocationsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c NamedLocationsClient) List(ctx context.Context) ([]NamedLocation, error) {
+	locations := make([]NamedLocation, 0)
+
+	path := "/identity/conditionalAccess/namedLocations"
+	for path != "" {
+		var result struct {
+			NextLink *string         `json:"@odata.nextLink"`
+			Value    []NamedLocation `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Named Locations: %+v", err)
+		}
+
+		locations = append(locations, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return locations, nil
+}
+
+func (c NamedLocationsClient) Get(ctx context.Context, id string) (*NamedLocation, int, error) {
+	var location NamedLocation
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identity/conditionalAccess/namedLocations/%s", id), &location)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &location, status, nil
+}
+
+func (c NamedLocationsClient) Create(ctx context.Context, location NamedLocation) (*NamedLocation, int, error) {
+	var result NamedLocation
+	status, err := c.BaseClient.Post(ctx, "/identity/conditionalAccess/namedLocations", location, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c NamedLocationsClient) Update(ctx context.Context, id string, location NamedLocation) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/identity/conditionalAccess/namedLocations/%s", id), location)
+}
+
+func (c NamedLocationsClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/identity/conditionalAccess/namedLocations/%s", id))
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type OrganizationalBranding struct {
+	ID                         *string `json:"id,omitempty"`
+	BackgroundColor            *string `json:"backgroundColor,omitempty"`
+	SignInPageText             *string `json:"signInPageText,omitempty"`
+	UsernameHintText           *string `json:"usernameHintText,omitempty"`
+	BackgroundImageRelativeUrl *string `json:"backgroundImageRelativeUrl,omitempty"`
+	BannerLogoRelativeUrl      *string `json:"bannerLogoRelativeUrl,omitempty"`
+}
+
+type OrganizationBrandingClient struct {
+	BaseClient
+}
+
+func NewOrganizationBrandingClient(endpoint, tenantId string) OrganizationBrandingClient {
+	return OrganizationBrandingClient{
+		// organizational branding is only available in the beta version of Microsoft Graph
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+// GetLocalization returns the branding for the given locale. The default branding
+// has the locale ID "0".
+func (c OrganizationBrandingClient) GetLocalization(ctx context.Context, organizationId, localeId string) (*OrganizationalBranding, int, error) {
+	var branding OrganizationalBranding
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/organization/%s/branding/localizations/%s", organizationId, localeId), &branding)
+	if err != nil {
+		return nil, status, err
+	}
+	return &branding, status, nil
+}
+
+func (c OrganizationBrandingClient) CreateLocalization(ctx context.Context, organizationId string, branding OrganizationalBranding) (*OrganizationalBranding, int, error) {
+	var result OrganizationalBranding
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/organization/%s/branding/localizations", organizationId), branding, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c OrganizationBrandingClient) UpdateLocalization(ctx context.Context, organizationId, localeId string, branding OrganizationalBranding) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/organization/%s/branding/localizations/%s", organizationId, localeId), branding)
+}
+
+func (c OrganizationBrandingClient) DeleteLocalization(ctx context.Context, organizationId, localeId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/organization/%s/branding/localizations/%s", organizationId, localeId))
+}
+
+// SetImage uploads a branding image, where imageName is one of the image properties
+// of organizationalBranding, e.g. backgroundImage or bannerLogo.
+func (c OrganizationBrandingClient) SetImage(ctx context.Context, organizationId, localeId, imageName, contentType string, data []byte) (int, error) {
+	return c.BaseClient.PutBinary(ctx, fmt.Sprintf("/organization/%s/branding/localizations/%s/%s", organizationId, localeId, imageName), contentType, data)
+}
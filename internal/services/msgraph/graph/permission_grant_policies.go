@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type PermissionGrantPolicy struct {
+	ID          *string `json:"id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+type PermissionGrantConditionSet struct {
+	ID                                          *string   `json:"id,omitempty"`
+	ClientApplicationIds                        *[]string `json:"clientApplicationIds,omitempty"`
+	ClientApplicationPublisherIds               *[]string `json:"clientApplicationPublisherIds,omitempty"`
+	ClientApplicationTenantIds                  *[]string `json:"clientApplicationTenantIds,omitempty"`
+	ClientApplicationsFromVerifiedPublisherOnly *bool     `json:"clientApplicationsFromVerifiedPublisherOnly,omitempty"`
+	PermissionClassification                    *string   `json:"permissionClassification,omitempty"`
+	PermissionType                              *string   `json:"permissionType,omitempty"`
+	Permissions                                 *[]string `json:"permissions,omitempty"`
+	ResourceApplication                         *string   `json:"resourceApplication,omitempty"`
+}
+
+type PermissionGrantPoliciesClient struct {
+	BaseClient
+}
+
+func NewPermissionGrantPoliciesClient(endpoint, tenantId string) PermissionGrantPoliciesClient {
+	return PermissionGrantPoliciesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c PermissionGrantPoliciesClient) Get(ctx context.Context, policyId string) (*PermissionGrantPolicy, int, error) {
+	var policy PermissionGrantPolicy
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/policies/permissionGrantPolicies/%s", policyId), &policy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &policy, status, nil
+}
+
+func (c PermissionGrantPoliciesClient) Create(ctx context.Context, policy PermissionGrantPolicy) (*PermissionGrantPolicy, int, error) {
+	var result PermissionGrantPolicy
+	status, err := c.BaseClient.Post(ctx, "/policies/permissionGrantPolicies", policy, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c PermissionGrantPoliciesClient) Update(ctx context.Context, policyId string, policy PermissionGrantPolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/policies/permissionGrantPolicies/%s", policyId), policy)
+}
+
+func (c PermissionGrantPoliciesClient) Delete(ctx context.Context, policyId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/policies/permissionGrantPolicies/%s", policyId))
+}
+
+// ListConditionSets returns the condition sets in the given list of the policy, where
+// listName is either "includes" or "excludes".
+func (c PermissionGrantPoliciesClient) ListConditionSets(ctx context.Context, policyId, listName string) ([]PermissionGrantConditionSet, int, error) {
+	var result struct {
+		Value []PermissionGrantConditionSet `json:"value"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/policies/permissionGrantPolicies/%s/%s", policyId, listName), &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return result.Value, status, nil
+}
+
+func (c PermissionGrantPoliciesClient) AddConditionSet(ctx context.Context, policyId, listName string, conditionSet PermissionGrantConditionSet) (int, error) {
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/policies/permissionGrantPolicies/%s/%s", policyId, listName), conditionSet, nil)
+}
+
+func (c PermissionGrantPoliciesClient) RemoveConditionSet(ctx context.Context, policyId, listName, conditionSetId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/policies/permissionGrantPolicies/%s/%s/%s", policyId, listName, conditionSetId))
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type PolicyAssignmentId struct {
+	ObjectType string
+	ObjectId   string
+	PolicyType string
+	PolicyId   string
+}
+
+func (id PolicyAssignmentId) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", id.ObjectType, id.ObjectId, id.PolicyType, id.PolicyId)
+}
+
+func PolicyAssignmentIdFrom(objectType, objectId, policyType, policyId string) PolicyAssignmentId {
+	return PolicyAssignmentId{
+		ObjectType: objectType,
+		ObjectId:   objectId,
+		PolicyType: policyType,
+		PolicyId:   policyId,
+	}
+}
+
+func ParsePolicyAssignmentId(idString string) (*PolicyAssignmentId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("Policy Assignment ID should be in the format {objectType}/{objectId}/{policyType}/{policyId} - but got %q", idString)
+	}
+
+	if parts[0] != "applications" && parts[0] != "servicePrincipals" {
+		return nil, fmt.Errorf("specified object type (%q) should be either \"applications\" or \"servicePrincipals\"", parts[0])
+	}
+
+	if _, err := uuid.ParseUUID(parts[1]); err != nil {
+		return nil, fmt.Errorf("specified object ID (%q) is not valid: %s", parts[1], err)
+	}
+
+	if _, err := uuid.ParseUUID(parts[3]); err != nil {
+		return nil, fmt.Errorf("specified policy ID (%q) is not valid: %s", parts[3], err)
+	}
+
+	return &PolicyAssignmentId{
+		ObjectType: parts[0],
+		ObjectId:   parts[1],
+		PolicyType: parts[2],
+		PolicyId:   parts[3],
+	}, nil
+}
+
+type PolicyAssignmentsClient struct {
+	BaseClient
+}
+
+func NewPolicyAssignmentsClient(endpoint, tenantId string) PolicyAssignmentsClient {
+	return PolicyAssignmentsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+// Assign links the given policy to an application or service principal, where objectType
+// is either "applications" or "servicePrincipals" and collection is the navigation property
+// for the policy type, e.g. "tokenIssuancePolicies".
+func (c PolicyAssignmentsClient) Assign(ctx context.Context, objectType, objectId, collection, policyId string) (int, error) {
+	body := map[string]interface{}{
+		"@odata.id": fmt.Sprintf("%s/%s/policies/%s/%s", strings.TrimSuffix(c.Endpoint, "/"), c.ApiVersion, collection, policyId),
+	}
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/%s/%s/%s/$ref", objectType, objectId, collection), body, nil)
+}
+
+func (c PolicyAssignmentsClient) List(ctx context.Context, objectType, objectId, collection string) ([]string, int, error) {
+	var result struct {
+		Value []struct {
+			ID *string `json:"id"`
+		} `json:"value"`
+	}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/%s/%s/%s", objectType, objectId, collection), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	policyIds := make([]string, 0)
+	for _, policy := range result.Value {
+		if policy.ID != nil {
+			policyIds = append(policyIds, *policy.ID)
+		}
+	}
+	return policyIds, status, nil
+}
+
+func (c PolicyAssignmentsClient) Remove(ctx context.Context, objectType, objectId, collection, policyId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/%s/%s/%s/%s/$ref", objectType, objectId, collection, policyId))
+}
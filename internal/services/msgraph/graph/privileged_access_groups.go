@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	PrivilegedAccessGroupAccessIdMember = "member"
+	PrivilegedAccessGroupAccessIdOwner  = "owner"
+)
+
+type PrivilegedAccessGroupScheduleRequest struct {
+	ID            *string           `json:"id,omitempty"`
+	AccessId      *string           `json:"accessId,omitempty"`
+	Action        *string           `json:"action,omitempty"`
+	GroupId       *string           `json:"groupId,omitempty"`
+	Justification *string           `json:"justification,omitempty"`
+	PrincipalId   *string           `json:"principalId,omitempty"`
+	ScheduleInfo  *RoleScheduleInfo `json:"scheduleInfo,omitempty"`
+	Status        *string           `json:"status,omitempty"`
+}
+
+type PrivilegedAccessGroupEligibilityScheduleRequestsClient struct {
+	BaseClient
+}
+
+func NewPrivilegedAccessGroupEligibilityScheduleRequestsClient(endpoint, tenantId string) PrivilegedAccessGroupEligibilityScheduleRequestsClient {
+	return PrivilegedAccessGroupEligibilityScheduleRequestsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c PrivilegedAccessGroupEligibilityScheduleRequestsClient) Get(ctx context.Context, id string) (*PrivilegedAccessGroupScheduleRequest, int, error) {
+	var request PrivilegedAccessGroupScheduleRequest
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identityGovernance/privilegedAccess/group/eligibilityScheduleRequests/%s", id), &request)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &request, status, nil
+}
+
+func (c PrivilegedAccessGroupEligibilityScheduleRequestsClient) Create(ctx context.Context, request PrivilegedAccessGroupScheduleRequest) (*PrivilegedAccessGroupScheduleRequest, int, error) {
+	var result PrivilegedAccessGroupScheduleRequest
+	status, err := c.BaseClient.Post(ctx, "/identityGovernance/privilegedAccess/group/eligibilityScheduleRequests", request, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+type PrivilegedAccessGroupAssignmentScheduleRequestsClient struct {
+	BaseClient
+}
+
+func NewPrivilegedAccessGroupAssignmentScheduleRequestsClient(endpoint, tenantId string) PrivilegedAccessGroupAssignmentScheduleRequestsClient {
+	return PrivilegedAccessGroupAssignmentScheduleRequestsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c PrivilegedAccessGroupAssignmentScheduleRequestsClient) Get(ctx context.Context, id string) (*PrivilegedAccessGroupScheduleRequest, int, error) {
+	var request PrivilegedAccessGroupScheduleRequest
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/identityGovernance/privilegedAccess/group/assignmentScheduleRequests/%s", id), &request)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &request, status, nil
+}
+
+func (c PrivilegedAccessGroupAssignmentScheduleRequestsClient) Create(ctx context.Context, request PrivilegedAccessGroupScheduleRequest) (*PrivilegedAccessGroupScheduleRequest, int, error) {
+	var result PrivilegedAccessGroupScheduleRequest
+	status, err := c.BaseClient.Post(ctx, "/identityGovernance/privilegedAccess/group/assignmentScheduleRequests", request, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
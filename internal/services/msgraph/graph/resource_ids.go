@@ -0,0 +1,59 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// ObjectSubResourceId addresses a sub-resource of a directory object, in the same
+// {objectId}/{type}/{subId} format used by the aadgraph service.
+type ObjectSubResourceId struct {
+	objectId string
+	subId    string
+	Type     string
+}
+
+func (id ObjectSubResourceId) String() string {
+	return fmt.Sprintf("%s/%s/%s", id.objectId, id.Type, id.subId)
+}
+
+func ObjectSubResourceIdFrom(objectId, typeId, subId string) ObjectSubResourceId {
+	return ObjectSubResourceId{
+		objectId: objectId,
+		Type:     typeId,
+		subId:    subId,
+	}
+}
+
+func ParseObjectSubResourceId(idString, expectedType string) (*ObjectSubResourceId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Object Resource ID should be in the format {objectId}/{type}/{subId} - but got %q", idString)
+	}
+
+	id := ObjectSubResourceId{
+		objectId: parts[0],
+		Type:     parts[1],
+		subId:    parts[2],
+	}
+
+	if _, err := uuid.ParseUUID(id.objectId); err != nil {
+		return nil, fmt.Errorf("Object ID isn't a valid UUID (%q): %+v", id.objectId, err)
+	}
+
+	if id.Type == "" {
+		return nil, fmt.Errorf("Type in {objectID}/{type}/{subID} should not blank")
+	}
+
+	if id.Type != expectedType {
+		return nil, fmt.Errorf("Type in {objectID}/{type}/{subID} was expected to be %s, got %s", expectedType, id.Type)
+	}
+
+	if _, err := uuid.ParseUUID(id.subId); err != nil {
+		return nil, fmt.Errorf("Object Sub Resource ID isn't a valid UUID (%q): %+v", id.subId, err)
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	RoleScheduleRequestActionAdminAssign = "adminAssign"
+	RoleScheduleRequestActionAdminRemove = "adminRemove"
+
+	RoleScheduleExpirationTypeAfterDateTime = "afterDateTime"
+	RoleScheduleExpirationTypeNoExpiration  = "noExpiration"
+)
+
+type RoleScheduleExpiration struct {
+	Type        *string `json:"type,omitempty"`
+	EndDateTime *string `json:"endDateTime,omitempty"`
+	Duration    *string `json:"duration,omitempty"`
+}
+
+type RoleScheduleInfo struct {
+	StartDateTime *string                 `json:"startDateTime,omitempty"`
+	Expiration    *RoleScheduleExpiration `json:"expiration,omitempty"`
+}
+
+type UnifiedRoleEligibilityScheduleRequest struct {
+	ID               *string           `json:"id,omitempty"`
+	Action           *string           `json:"action,omitempty"`
+	DirectoryScopeId *string           `json:"directoryScopeId,omitempty"`
+	Justification    *string           `json:"justification,omitempty"`
+	PrincipalId      *string           `json:"principalId,omitempty"`
+	RoleDefinitionId *string           `json:"roleDefinitionId,omitempty"`
+	ScheduleInfo     *RoleScheduleInfo `json:"scheduleInfo,omitempty"`
+	Status           *string           `json:"status,omitempty"`
+}
+
+type RoleEligibilityScheduleRequestsClient struct {
+	BaseClient
+}
+
+func NewRoleEligibilityScheduleRequestsClient(endpoint, tenantId string) RoleEligibilityScheduleRequestsClient {
+	return RoleEligibilityScheduleRequestsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c RoleEligibilityScheduleRequestsClient) Get(ctx context.Context, id string) (*UnifiedRoleEligibilityScheduleRequest, int, error) {
+	var request UnifiedRoleEligibilityScheduleRequest
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/roleManagement/directory/roleEligibilityScheduleRequests/%s", id), &request)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &request, status, nil
+}
+
+func (c RoleEligibilityScheduleRequestsClient) Create(ctx context.Context, request UnifiedRoleEligibilityScheduleRequest) (*UnifiedRoleEligibilityScheduleRequest, int, error) {
+	var result UnifiedRoleEligibilityScheduleRequest
+	status, err := c.BaseClient.Post(ctx, "/roleManagement/directory/roleEligibilityScheduleRequests", request, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type UnifiedRolePermission struct {
+	AllowedResourceActions  *[]string `json:"allowedResourceActions,omitempty"`
+	ExcludedResourceActions *[]string `json:"excludedResourceActions,omitempty"`
+	Condition               *string   `json:"condition,omitempty"`
+}
+
+type UnifiedRoleDefinition struct {
+	ID              *string                  `json:"id,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	DisplayName     *string                  `json:"displayName,omitempty"`
+	IsEnabled       *bool                    `json:"isEnabled,omitempty"`
+	RolePermissions *[]UnifiedRolePermission `json:"rolePermissions,omitempty"`
+	TemplateId      *string                  `json:"templateId,omitempty"`
+	Version         *string                  `json:"version,omitempty"`
+	IsBuiltIn       *bool                    `json:"isBuiltIn,omitempty"`
+}
+
+type UnifiedRoleAssignment struct {
+	ID               *string `json:"id,omitempty"`
+	AppScopeId       *string `json:"appScopeId,omitempty"`
+	DirectoryScopeId *string `json:"directoryScopeId,omitempty"`
+	PrincipalId      *string `json:"principalId,omitempty"`
+	RoleDefinitionId *string `json:"roleDefinitionId,omitempty"`
+}
+
+type RoleDefinitionsClient struct {
+	BaseClient
+}
+
+func NewRoleDefinitionsClient(endpoint, tenantId string) RoleDefinitionsClient {
+	return RoleDefinitionsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c RoleDefinitionsClient) Get(ctx context.Context, id string) (*UnifiedRoleDefinition, int, error) {
+	var roleDefinition UnifiedRoleDefinition
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/roleManagement/directory/roleDefinitions/%s", id), &roleDefinition)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &roleDefinition, status, nil
+}
+
+func (c RoleDefinitionsClient) Create(ctx context.Context, roleDefinition UnifiedRoleDefinition) (*UnifiedRoleDefinition, int, error) {
+	var result UnifiedRoleDefinition
+	status, err := c.BaseClient.Post(ctx, "/roleManagement/directory/roleDefinitions", roleDefinition, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c RoleDefinitionsClient) Update(ctx context.Context, id string, roleDefinition UnifiedRoleDefinition) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/roleManagement/directory/roleDefinitions/%s", id), roleDefinition)
+}
+
+func (c RoleDefinitionsClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/roleManagement/directory/roleDefinitions/%s", id))
+}
+
+type RoleAssignmentsClient struct {
+	BaseClient
+}
+
+func NewRoleAssignmentsClient(endpoint, tenantId string) RoleAssignmentsClient {
+	return RoleAssignmentsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c RoleAssignmentsClient) Get(ctx context.Context, id string) (*UnifiedRoleAssignment, int, error) {
+	var roleAssignment UnifiedRoleAssignment
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/roleManagement/directory/roleAssignments/%s", id), &roleAssignment)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &roleAssignment, status, nil
+}
+
+func (c RoleAssignmentsClient) Create(ctx context.Context, roleAssignment UnifiedRoleAssignment) (*UnifiedRoleAssignment, int, error) {
+	var result UnifiedRoleAssignment
+	status, err := c.BaseClient.Post(ctx, "/roleManagement/directory/roleAssignments", roleAssignment, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c RoleAssignmentsClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/roleManagement/directory/roleAssignments/%s", id))
+}
+
+// EntitlementRoleAssignmentsClient manages role assignments scoped to entitlement
+// management, such as catalog owners and access package managers.
+type EntitlementRoleAssignmentsClient struct {
+	BaseClient
+}
+
+func NewEntitlementRoleAssignmentsClient(endpoint, tenantId string) EntitlementRoleAssignmentsClient {
+	return EntitlementRoleAssignmentsClient{
+		// entitlement management is only available in the beta version of Microsoft Graph
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c EntitlementRoleAssignmentsClient) Get(ctx context.Context, id string) (*UnifiedRoleAssignment, int, error) {
+	var roleAssignment UnifiedRoleAssignment
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/roleManagement/entitlementManagement/roleAssignments/%s", id), &roleAssignment)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &roleAssignment, status, nil
+}
+
+func (c EntitlementRoleAssignmentsClient) Create(ctx context.Context, roleAssignment UnifiedRoleAssignment) (*UnifiedRoleAssignment, int, error) {
+	var result UnifiedRoleAssignment
+	status, err := c.BaseClient.Post(ctx, "/roleManagement/entitlementManagement/roleAssignments", roleAssignment, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c EntitlementRoleAssignmentsClient) Delete(ctx context.Context, id string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/roleManagement/entitlementManagement/roleAssignments/%s", id))
+}
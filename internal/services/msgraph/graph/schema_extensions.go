@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	SchemaExtensionStatusAvailable     = "Available"
+	SchemaExtensionStatusDeprecated    = "Deprecated"
+	SchemaExtensionStatusInDevelopment = "InDevelopment"
+)
+
+const (
+	SchemaExtensionPropertyTypeBinary   = "Binary"
+	SchemaExtensionPropertyTypeBoolean  = "Boolean"
+	SchemaExtensionPropertyTypeDateTime = "DateTime"
+	SchemaExtensionPropertyTypeInteger  = "Integer"
+	SchemaExtensionPropertyTypeString   = "String"
+)
+
+type SchemaExtensionProperty struct {
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+type SchemaExtension struct {
+	ID          *string                    `json:"id,omitempty"`
+	Description *string                    `json:"description,omitempty"`
+	Owner       *string                    `json:"owner,omitempty"`
+	Properties  *[]SchemaExtensionProperty `json:"properties,omitempty"`
+	Status      *string                    `json:"status,omitempty"`
+	TargetTypes *[]string                  `json:"targetTypes,omitempty"`
+}
+
+type SchemaExtensionsClient struct {
+	BaseClient
+}
+
+func NewSchemaExtensionsClient(endpoint, tenantId string) SchemaExtensionsClient {
+	return SchemaExtensionsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c SchemaExtensionsClient) Create(ctx context.Context, extension SchemaExtension) (*SchemaExtension, int, error) {
+	var result SchemaExtension
+	status, err := c.BaseClient.Post(ctx, "/schemaExtensions", extension, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c SchemaExtensionsClient) Get(ctx context.Context, extensionId string) (*SchemaExtension, int, error) {
+	var result SchemaExtension
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/schemaExtensions/%s", extensionId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c SchemaExtensionsClient) Update(ctx context.Context, extensionId string, extension SchemaExtension) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/schemaExtensions/%s", extensionId), extension)
+}
+
+func (c SchemaExtensionsClient) Delete(ctx context.Context, extensionId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/schemaExtensions/%s", extensionId))
+}
@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type SamlSingleSignOnSettings struct {
+	RelayState *string `json:"relayState,omitempty"`
+}
+
+type ServicePrincipal struct {
+	ID                         *string                   `json:"id,omitempty"`
+	LoginUrl                   *string                   `json:"loginUrl,omitempty"`
+	NotificationEmailAddresses *[]string                 `json:"notificationEmailAddresses,omitempty"`
+	PreferredSingleSignOnMode  *string                   `json:"preferredSingleSignOnMode,omitempty"`
+	SamlSingleSignOnSettings   *SamlSingleSignOnSettings `json:"samlSingleSignOnSettings,omitempty"`
+}
+
+type ServicePrincipalsClient struct {
+	BaseClient
+}
+
+func NewServicePrincipalsClient(endpoint, tenantId string) ServicePrincipalsClient {
+	return ServicePrincipalsClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c ServicePrincipalsClient) Get(ctx context.Context, servicePrincipalId string) (*ServicePrincipal, int, error) {
+	var servicePrincipal ServicePrincipal
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/servicePrincipals/%s", servicePrincipalId), &servicePrincipal)
+	if err != nil {
+		return nil, status, err
+	}
+	return &servicePrincipal, status, nil
+}
+
+func (c ServicePrincipalsClient) Update(ctx context.Context, servicePrincipalId string, servicePrincipal ServicePrincipal) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/servicePrincipals/%s", servicePrincipalId), servicePrincipal)
+}
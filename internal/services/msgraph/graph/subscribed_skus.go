@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type SubscribedSku struct {
+	ID               *string `json:"id,omitempty"`
+	AppliesTo        *string `json:"appliesTo,omitempty"`
+	CapabilityStatus *string `json:"capabilityStatus,omitempty"`
+	ConsumedUnits    *int32  `json:"consumedUnits,omitempty"`
+	SkuId            *string `json:"skuId,omitempty"`
+	SkuPartNumber    *string `json:"skuPartNumber,omitempty"`
+	PrepaidUnits     *struct {
+		Enabled   *int32 `json:"enabled,omitempty"`
+		Suspended *int32 `json:"suspended,omitempty"`
+		Warning   *int32 `json:"warning,omitempty"`
+	} `json:"prepaidUnits,omitempty"`
+}
+
+type SubscribedSkusClient struct {
+	BaseClient
+}
+
+func NewSubscribedSkusClient(endpoint, tenantId string) SubscribedSkusClient {
+	return SubscribedSkusClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c SubscribedSkusClient) List(ctx context.Context) ([]SubscribedSku, error) {
+	skus := make([]SubscribedSku, 0)
+
+	path := "/subscribedSkus"
+	for path != "" {
+		var result struct {
+			NextLink *string         `json:"@odata.nextLink"`
+			Value    []SubscribedSku `json:"value"`
+		}
+
+		if _, err := c.BaseClient.Get(ctx, path, &result); err != nil {
+			return nil, fmt.Errorf("listing Subscribed SKUs: %+v", err)
+		}
+
+		skus = append(skus, result.Value...)
+
+		path = ""
+		if result.NextLink != nil {
+			path = *result.NextLink
+		}
+	}
+
+	return skus, nil
+}
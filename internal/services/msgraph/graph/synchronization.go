@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type SynchronizationSchedule struct {
+	Expiration *string `json:"expiration,omitempty"`
+	Interval   *string `json:"interval,omitempty"`
+	State      *string `json:"state,omitempty"`
+}
+
+type SynchronizationStatus struct {
+	Code *string `json:"code,omitempty"`
+}
+
+type SynchronizationJob struct {
+	ID         *string                  `json:"id,omitempty"`
+	TemplateId *string                  `json:"templateId,omitempty"`
+	Schedule   *SynchronizationSchedule `json:"schedule,omitempty"`
+	Status     *SynchronizationStatus   `json:"status,omitempty"`
+}
+
+type SynchronizationSecretKeyStringValuePair struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// SynchronizationJobId addresses a synchronization job on a service principal. Job IDs
+// are not UUIDs (e.g. "Azure2Azure.00000000000000000000000000000000"), so this does not
+// reuse ObjectSubResourceId.
+type SynchronizationJobId struct {
+	ServicePrincipalId string
+	JobId              string
+}
+
+func (id SynchronizationJobId) String() string {
+	return fmt.Sprintf("%s/%s", id.ServicePrincipalId, id.JobId)
+}
+
+func SynchronizationJobIdFrom(servicePrincipalId, jobId string) SynchronizationJobId {
+	return SynchronizationJobId{
+		ServicePrincipalId: servicePrincipalId,
+		JobId:              jobId,
+	}
+}
+
+func ParseSynchronizationJobId(idString string) (*SynchronizationJobId, error) {
+	parts := strings.SplitN(idString, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Synchronization Job ID should be in the format {servicePrincipalId}/{jobId} - but got %q", idString)
+	}
+
+	if _, err := uuid.ParseUUID(parts[0]); err != nil {
+		return nil, fmt.Errorf("Service Principal ID isn't a valid UUID (%q): %+v", parts[0], err)
+	}
+
+	return &SynchronizationJobId{
+		ServicePrincipalId: parts[0],
+		JobId:              parts[1],
+	}, nil
+}
+
+type SynchronizationClient struct {
+	BaseClient
+}
+
+func NewSynchronizationClient(endpoint, tenantId string) SynchronizationClient {
+	return SynchronizationClient{
+		// the synchronization API is only available in the beta version of Microsoft Graph
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c SynchronizationClient) GetJob(ctx context.Context, servicePrincipalId, jobId string) (*SynchronizationJob, int, error) {
+	var job SynchronizationJob
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s", servicePrincipalId, jobId), &job)
+	if err != nil {
+		return nil, status, err
+	}
+	return &job, status, nil
+}
+
+func (c SynchronizationClient) CreateJob(ctx context.Context, servicePrincipalId string, job SynchronizationJob) (*SynchronizationJob, int, error) {
+	var result SynchronizationJob
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs", servicePrincipalId), job, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c SynchronizationClient) DeleteJob(ctx context.Context, servicePrincipalId, jobId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s", servicePrincipalId, jobId))
+}
+
+func (c SynchronizationClient) StartJob(ctx context.Context, servicePrincipalId, jobId string) (int, error) {
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s/start", servicePrincipalId, jobId), nil, nil)
+}
+
+func (c SynchronizationClient) PauseJob(ctx context.Context, servicePrincipalId, jobId string) (int, error) {
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s/pause", servicePrincipalId, jobId), nil, nil)
+}
+
+type SynchronizationJobSubject struct {
+	ObjectId       *string `json:"objectId,omitempty"`
+	ObjectTypeName *string `json:"objectTypeName,omitempty"`
+}
+
+type SynchronizationJobApplicationParameters struct {
+	RuleId   *string                      `json:"ruleId,omitempty"`
+	Subjects *[]SynchronizationJobSubject `json:"subjects,omitempty"`
+}
+
+// ProvisionJobOnDemand synchronously provisions the given subjects through the job,
+// without waiting for the next scheduled synchronization cycle.
+func (c SynchronizationClient) ProvisionJobOnDemand(ctx context.Context, servicePrincipalId, jobId string, parameters []SynchronizationJobApplicationParameters) (int, error) {
+	body := struct {
+		Parameters []SynchronizationJobApplicationParameters `json:"parameters"`
+	}{
+		Parameters: parameters,
+	}
+	return c.BaseClient.Post(ctx, fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s/provisionOnDemand", servicePrincipalId, jobId), body, nil)
+}
+
+// SetSecrets replaces the synchronization secrets on the service principal. The API does
+// not support reading secrets back, so callers must track the configured values themselves.
+func (c SynchronizationClient) SetSecrets(ctx context.Context, servicePrincipalId string, secrets []SynchronizationSecretKeyStringValuePair) (int, error) {
+	body := struct {
+		Value []SynchronizationSecretKeyStringValuePair `json:"value"`
+	}{
+		Value: secrets,
+	}
+	return c.BaseClient.Put(ctx, fmt.Sprintf("/servicePrincipals/%s/synchronization/secrets", servicePrincipalId), body)
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+)
+
+type TokenIssuancePolicy struct {
+	ID                    *string   `json:"id,omitempty"`
+	Definition            *[]string `json:"definition,omitempty"`
+	DisplayName           *string   `json:"displayName,omitempty"`
+	IsOrganizationDefault *bool     `json:"isOrganizationDefault,omitempty"`
+}
+
+type TokenIssuancePoliciesClient struct {
+	BaseClient
+}
+
+func NewTokenIssuancePoliciesClient(endpoint, tenantId string) TokenIssuancePoliciesClient {
+	return TokenIssuancePoliciesClient{
+		BaseClient: NewBaseClient(endpoint, tenantId, Version10),
+	}
+}
+
+func (c TokenIssuancePoliciesClient) Get(ctx context.Context, policyId string) (*TokenIssuancePolicy, int, error) {
+	var policy TokenIssuancePolicy
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/policies/tokenIssuancePolicies/%s", policyId), &policy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &policy, status, nil
+}
+
+func (c TokenIssuancePoliciesClient) Create(ctx context.Context, policy TokenIssuancePolicy) (*TokenIssuancePolicy, int, error) {
+	var result TokenIssuancePolicy
+	status, err := c.BaseClient.Post(ctx, "/policies/tokenIssuancePolicies", policy, &result)
+	if err != nil {
+		return nil, status, err
+	}
+	return &result, status, nil
+}
+
+func (c TokenIssuancePoliciesClient) Update(ctx context.Context, policyId string, policy TokenIssuancePolicy) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/policies/tokenIssuancePolicies/%s", policyId), policy)
+}
+
+func (c TokenIssuancePoliciesClient) Delete(ctx context.Context, policyId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/policies/tokenIssuancePolicies/%s", policyId))
+}
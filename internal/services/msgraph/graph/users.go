@@ -0,0 +1,178 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	PhoneMethodTypeAlternateMobile = "alternateMobile"
+	PhoneMethodTypeMobile          = "mobile"
+	PhoneMethodTypeOffice          = "office"
+)
+
+type PhoneAuthenticationMethod struct {
+	ID          *string `json:"id,omitempty"`
+	PhoneNumber *string `json:"phoneNumber,omitempty"`
+	PhoneType   *string `json:"phoneType,omitempty"`
+}
+
+type UserPhoneMethodId struct {
+	ObjectSubResourceId
+	UserId   string
+	MethodId string
+}
+
+func UserPhoneMethodIdFrom(userId, methodId string) UserPhoneMethodId {
+	return UserPhoneMethodId{
+		ObjectSubResourceId: ObjectSubResourceIdFrom(userId, "phoneMethod", methodId),
+		UserId:              userId,
+		MethodId:            methodId,
+	}
+}
+
+func ParseUserPhoneMethodId(idString string) (*UserPhoneMethodId, error) {
+	id, err := ParseObjectSubResourceId(idString, "phoneMethod")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Phone Method ID: %v", err)
+	}
+
+	return &UserPhoneMethodId{
+		ObjectSubResourceId: *id,
+		UserId:              id.objectId,
+		MethodId:            id.subId,
+	}, nil
+}
+
+type EmployeeOrgData struct {
+	CostCenter *string `json:"costCenter,omitempty"`
+	Division   *string `json:"division,omitempty"`
+}
+
+type UserPasswordProfile struct {
+	ForceChangePasswordNextSignIn        *bool   `json:"forceChangePasswordNextSignIn,omitempty"`
+	ForceChangePasswordNextSignInWithMfa *bool   `json:"forceChangePasswordNextSignInWithMfa,omitempty"`
+	Password                             *string `json:"password,omitempty"`
+}
+
+type User struct {
+	ID                    *string              `json:"id,omitempty"`
+	EmployeeHireDate      *string              `json:"employeeHireDate,omitempty"`
+	EmployeeLeaveDateTime *string              `json:"employeeLeaveDateTime,omitempty"`
+	EmployeeOrgData       *EmployeeOrgData     `json:"employeeOrgData,omitempty"`
+	EmployeeType          *string              `json:"employeeType,omitempty"`
+	PasswordProfile       *UserPasswordProfile `json:"passwordProfile,omitempty"`
+}
+
+type UsersClient struct {
+	BaseClient
+}
+
+func NewUsersClient(endpoint, tenantId string) UsersClient {
+	return UsersClient{
+		// employeeLeaveDateTime is only exposed by the beta endpoint
+		BaseClient: NewBaseClient(endpoint, tenantId, VersionBeta),
+	}
+}
+
+func (c UsersClient) Get(ctx context.Context, userId string) (*User, int, error) {
+	var result User
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/users/%s", userId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c UsersClient) Update(ctx context.Context, userId string, user User) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/users/%s", userId), user)
+}
+
+// UpdateExtensionAttributes sets directory extension property values for a user.
+// The attribute names are the fully qualified extension property names, and a nil
+// value clears the attribute.
+func (c UsersClient) UpdateExtensionAttributes(ctx context.Context, userId string, attributes map[string]interface{}) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/users/%s", userId), attributes)
+}
+
+// GetExtensionAttributes returns the values of the named directory extension
+// properties for a user. Attributes with no value are omitted from the result.
+func (c UsersClient) GetExtensionAttributes(ctx context.Context, userId string, names []string) (map[string]string, int, error) {
+	var result map[string]interface{}
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/users/%s?$select=%s", userId, strings.Join(names, ",")), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	values := make(map[string]string)
+	for _, name := range names {
+		if v, ok := result[name]; ok && v != nil {
+			values[name] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return values, status, nil
+}
+
+// GetManager returns the object ID of the user's manager. A status of
+// http.StatusNotFound indicates that the user has no manager assigned.
+func (c UsersClient) GetManager(ctx context.Context, userId string) (string, int, error) {
+	var result struct {
+		ID *string `json:"id"`
+	}
+
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/users/%s/manager?$select=id", userId), &result)
+	if err != nil {
+		return "", status, err
+	}
+
+	if result.ID == nil {
+		return "", status, fmt.Errorf("nil manager ID returned for User with ID %q", userId)
+	}
+
+	return *result.ID, status, nil
+}
+
+func (c UsersClient) AssignManager(ctx context.Context, userId, managerId string) (int, error) {
+	body := struct {
+		ODataId string `json:"@odata.id"`
+	}{
+		ODataId: fmt.Sprintf("%s/%s/directoryObjects/%s", strings.TrimSuffix(c.Endpoint, "/"), c.ApiVersion, managerId),
+	}
+
+	return c.BaseClient.Put(ctx, fmt.Sprintf("/users/%s/manager/$ref", userId), body)
+}
+
+func (c UsersClient) RemoveManager(ctx context.Context, userId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/users/%s/manager/$ref", userId))
+}
+
+func (c UsersClient) CreatePhoneMethod(ctx context.Context, userId string, method PhoneAuthenticationMethod) (*PhoneAuthenticationMethod, int, error) {
+	var result PhoneAuthenticationMethod
+	status, err := c.BaseClient.Post(ctx, fmt.Sprintf("/users/%s/authentication/phoneMethods", userId), method, &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c UsersClient) GetPhoneMethod(ctx context.Context, userId, methodId string) (*PhoneAuthenticationMethod, int, error) {
+	var result PhoneAuthenticationMethod
+	status, err := c.BaseClient.Get(ctx, fmt.Sprintf("/users/%s/authentication/phoneMethods/%s", userId, methodId), &result)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &result, status, nil
+}
+
+func (c UsersClient) UpdatePhoneMethod(ctx context.Context, userId, methodId string, method PhoneAuthenticationMethod) (int, error) {
+	return c.BaseClient.Patch(ctx, fmt.Sprintf("/users/%s/authentication/phoneMethods/%s", userId, methodId), method)
+}
+
+func (c UsersClient) DeletePhoneMethod(ctx context.Context, userId, methodId string) (int, error) {
+	return c.BaseClient.Delete(ctx, fmt.Sprintf("/users/%s/authentication/phoneMethods/%s", userId, methodId))
+}
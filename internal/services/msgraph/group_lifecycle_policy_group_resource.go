@@ -0,0 +1,115 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func groupLifecyclePolicyGroupResource() *schema.Resource {
+	return &schema.Resource{
+		Create: groupLifecyclePolicyGroupResourceCreate,
+		Read:   groupLifecyclePolicyGroupResourceRead,
+		Delete: groupLifecyclePolicyGroupResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseGroupLifecyclePolicyGroupId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"group_lifecycle_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"group_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func groupLifecyclePolicyGroupResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id := graph.GroupLifecyclePolicyGroupIdFrom(d.Get("group_lifecycle_policy_id").(string), d.Get("group_object_id").(string))
+
+	if _, err := client.AddGroup(ctx, id.PolicyId, id.GroupId); err != nil {
+		return fmt.Errorf("adding group %q to Group Lifecycle Policy %q: %+v", id.GroupId, id.PolicyId, err)
+	}
+
+	d.SetId(id.String())
+
+	return groupLifecyclePolicyGroupResourceRead(d, meta)
+}
+
+func groupLifecyclePolicyGroupResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseGroupLifecyclePolicyGroupId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Group Lifecycle Policy Group ID %q: %+v", d.Id(), err)
+	}
+
+	policies, status, err := client.ListForGroup(ctx, id.GroupId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Group %q was not found - removing from state", id.GroupId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("listing lifecycle policies for group %q: %+v", id.GroupId, err)
+	}
+
+	var found bool
+	for _, policy := range policies {
+		if policy.ID != nil && *policy.ID == id.PolicyId {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Group %q is no longer subject to Group Lifecycle Policy %q - removing from state", id.GroupId, id.PolicyId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_lifecycle_policy_id", id.PolicyId)
+	d.Set("group_object_id", id.GroupId)
+
+	return nil
+}
+
+func groupLifecyclePolicyGroupResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseGroupLifecyclePolicyGroupId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Group Lifecycle Policy Group ID %q: %+v", d.Id(), err)
+	}
+
+	if status, err := client.RemoveGroup(ctx, id.PolicyId, id.GroupId); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("removing group %q from Group Lifecycle Policy %q: %+v", id.GroupId, id.PolicyId, err)
+		}
+	}
+
+	return nil
+}
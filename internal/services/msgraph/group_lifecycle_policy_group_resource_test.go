@@ -0,0 +1,115 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccGroupLifecyclePolicyGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupLifecyclePolicyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupLifecyclePolicyGroup_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupLifecyclePolicyGroupExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckGroupLifecyclePolicyGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		id, err := graph.ParseGroupLifecyclePolicyGroupId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("parsing Group Lifecycle Policy Group ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		policies, _, err := client.ListForGroup(ctx, id.GroupId)
+		if err != nil {
+			return fmt.Errorf("listing lifecycle policies for group %q: %+v", id.GroupId, err)
+		}
+
+		for _, policy := range policies {
+			if policy.ID != nil && *policy.ID == id.PolicyId {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("group %q is not subject to Group Lifecycle Policy %q", id.GroupId, id.PolicyId)
+	}
+}
+
+func testCheckGroupLifecyclePolicyGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_group_lifecycle_policy_group" {
+			continue
+		}
+
+		id, err := graph.ParseGroupLifecyclePolicyGroupId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("parsing Group Lifecycle Policy Group ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		policies, status, err := client.ListForGroup(ctx, id.GroupId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("listing lifecycle policies for group %q: %+v", id.GroupId, err)
+		}
+
+		for _, policy := range policies {
+			if policy.ID != nil && *policy.ID == id.PolicyId {
+				return fmt.Errorf("group %q is still subject to Group Lifecycle Policy %q", id.GroupId, id.PolicyId)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccGroupLifecyclePolicyGroup_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 180
+  managed_group_types    = "Selected"
+
+  alternate_notification_emails = ["admin-%[1]d@hashicorp.com"]
+}
+
+resource "azuread_group_lifecycle_policy_group" "test" {
+  group_lifecycle_policy_id = azuread_group_lifecycle_policy.test.id
+  group_object_id           = azuread_group.test.id
+}
+`, ri)
+}
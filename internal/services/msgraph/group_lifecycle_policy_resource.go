@@ -0,0 +1,139 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func groupLifecyclePolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: groupLifecyclePolicyResourceCreate,
+		Read:   groupLifecyclePolicyResourceRead,
+		Update: groupLifecyclePolicyResourceUpdate,
+		Delete: groupLifecyclePolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"group_lifetime_in_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+
+			"managed_group_types": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"All",
+					"None",
+					"Selected",
+				}, false),
+			},
+
+			"alternate_notification_emails": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandGroupLifecyclePolicy(d *schema.ResourceData) graph.GroupLifecyclePolicy {
+	// the API expects a single semicolon-separated string of email addresses
+	emails := tf.ExpandStringSlicePtr(d.Get("alternate_notification_emails").([]interface{}))
+
+	return graph.GroupLifecyclePolicy{
+		AlternateNotificationEmails: utils.String(strings.Join(*emails, ";")),
+		GroupLifetimeInDays:         utils.Int32(int32(d.Get("group_lifetime_in_days").(int))),
+		ManagedGroupTypes:           utils.String(d.Get("managed_group_types").(string)),
+	}
+}
+
+func groupLifecyclePolicyResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, _, err := client.Create(ctx, expandGroupLifecyclePolicy(d))
+	if err != nil {
+		return fmt.Errorf("creating Group Lifecycle Policy: %+v", err)
+	}
+	if policy == nil || policy.ID == nil || *policy.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Group Lifecycle Policy")
+	}
+
+	d.SetId(*policy.ID)
+
+	return groupLifecyclePolicyResourceRead(d, meta)
+}
+
+func groupLifecyclePolicyResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if _, err := client.Update(ctx, d.Id(), expandGroupLifecyclePolicy(d)); err != nil {
+		return fmt.Errorf("updating Group Lifecycle Policy %q: %+v", d.Id(), err)
+	}
+
+	return groupLifecyclePolicyResourceRead(d, meta)
+}
+
+func groupLifecyclePolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Group Lifecycle Policy %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Group Lifecycle Policy %q: %+v", d.Id(), err)
+	}
+
+	d.Set("group_lifetime_in_days", policy.GroupLifetimeInDays)
+	d.Set("managed_group_types", policy.ManagedGroupTypes)
+
+	emails := make([]string, 0)
+	if policy.AlternateNotificationEmails != nil && *policy.AlternateNotificationEmails != "" {
+		emails = strings.Split(*policy.AlternateNotificationEmails, ";")
+	}
+	d.Set("alternate_notification_emails", emails)
+
+	return nil
+}
+
+func groupLifecyclePolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Group Lifecycle Policy %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccGroupLifecyclePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupLifecyclePolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupLifecyclePolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "group_lifetime_in_days", "180"),
+					resource.TestCheckResourceAttr(data.ResourceName, "managed_group_types", "Selected"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccGroupLifecyclePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupLifecyclePolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupLifecyclePolicyExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccGroupLifecyclePolicy_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupLifecyclePolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "group_lifetime_in_days", "365"),
+					resource.TestCheckResourceAttr(data.ResourceName, "alternate_notification_emails.#", "2"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckGroupLifecyclePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Group Lifecycle Policy %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Group Lifecycle Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckGroupLifecyclePolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_group_lifecycle_policy" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.GroupLifecyclePoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving Group Lifecycle Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Group Lifecycle Policy %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGroupLifecyclePolicy_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 180
+  managed_group_types    = "Selected"
+
+  alternate_notification_emails = ["admin-%[1]d@hashicorp.com"]
+}
+`, ri)
+}
+
+func testAccGroupLifecyclePolicy_complete(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 365
+  managed_group_types    = "Selected"
+
+  alternate_notification_emails = ["admin-%[1]d@hashicorp.com", "ops-%[1]d@hashicorp.com"]
+}
+`, ri)
+}
@@ -0,0 +1,143 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func groupMembersResource() *schema.Resource {
+	return &schema.Resource{
+		Create: groupMembersResourceCreateUpdate,
+		Read:   groupMembersResourceRead,
+		Update: groupMembersResourceCreateUpdate,
+		Delete: groupMembersResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"group_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			// this resource is authoritative: members not present in this set are removed
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+		},
+	}
+}
+
+func groupMembersResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	groupId := d.Get("group_object_id").(string)
+
+	desired := make(map[string]bool)
+	for _, v := range d.Get("members").(*schema.Set).List() {
+		desired[v.(string)] = true
+	}
+
+	existing, _, err := client.ListMembers(ctx, groupId)
+	if err != nil {
+		return fmt.Errorf("listing members for group with object ID %q: %+v", groupId, err)
+	}
+
+	toAdd := make([]string, 0)
+	for memberId := range desired {
+		found := false
+		for _, existingId := range existing {
+			if existingId == memberId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			toAdd = append(toAdd, memberId)
+		}
+	}
+
+	if len(toAdd) > 0 {
+		if _, err := client.AddMembers(ctx, groupId, toAdd); err != nil {
+			return fmt.Errorf("adding members to group with object ID %q: %+v", groupId, err)
+		}
+	}
+
+	for _, existingId := range existing {
+		if !desired[existingId] {
+			if status, err := client.RemoveMember(ctx, groupId, existingId); err != nil {
+				if status != http.StatusNotFound {
+					return fmt.Errorf("removing member %q from group with object ID %q: %+v", existingId, groupId, err)
+				}
+			}
+		}
+	}
+
+	d.SetId(groupId)
+
+	return groupMembersResourceRead(d, meta)
+}
+
+func groupMembersResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	members, status, err := client.ListMembers(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Group with object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("listing members for group with object ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("group_object_id", d.Id())
+	d.Set("members", members)
+
+	return nil
+}
+
+func groupMembersResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	existing, status, err := client.ListMembers(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("listing members for group with object ID %q: %+v", d.Id(), err)
+	}
+
+	for _, memberId := range existing {
+		if status, err := client.RemoveMember(ctx, d.Id(), memberId); err != nil {
+			if status != http.StatusNotFound {
+				return fmt.Errorf("removing member %q from group with object ID %q: %+v", memberId, d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccGroupMembers_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_members", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupMembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembers_basic(data.RandomInteger, data.RandomPassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupMembersExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "members.#", "2"),
+				),
+			},
+			data.ImportStep(),
+			{
+				// members removed from the set should be removed from the group
+				Config: testAccGroupMembers_single(data.RandomInteger, data.RandomPassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupMembersExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "members.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckGroupMembersExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.GroupsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		members, status, err := client.ListMembers(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Group %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("listing members for group %q: %+v", rs.Primary.ID, err)
+		}
+
+		if len(members) == 0 {
+			return fmt.Errorf("Group %q has no members", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckGroupMembersDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_group_members" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.GroupsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		members, status, err := client.ListMembers(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("listing members for group %q: %+v", rs.Primary.ID, err)
+		}
+
+		if len(members) > 0 {
+			return fmt.Errorf("Group %q still has %d members", rs.Primary.ID, len(members))
+		}
+	}
+
+	return nil
+}
+
+func testAccGroupMembers_template(ri int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_user" "testA" {
+  user_principal_name = "acctestUser.%[1]d.A@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-A"
+  password            = "%[2]s"
+}
+
+resource "azuread_user" "testB" {
+  user_principal_name = "acctestUser.%[1]d.B@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d-B"
+  password            = "%[2]s"
+}
+`, ri, password)
+}
+
+func testAccGroupMembers_basic(ri int, password string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_members" "test" {
+  group_object_id = azuread_group.test.object_id
+
+  members = [
+    azuread_user.testA.object_id,
+    azuread_user.testB.object_id,
+  ]
+}
+`, testAccGroupMembers_template(ri, password))
+}
+
+func testAccGroupMembers_single(ri int, password string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_members" "test" {
+  group_object_id = azuread_group.test.object_id
+
+  members = [
+    azuread_user.testA.object_id,
+  ]
+}
+`, testAccGroupMembers_template(ri, password))
+}
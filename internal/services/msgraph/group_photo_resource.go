@@ -0,0 +1,107 @@
+package msgraph
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func groupPhotoResource() *schema.Resource {
+	return &schema.Resource{
+		Create: groupPhotoResourceCreate,
+		Read:   groupPhotoResourceRead,
+		Update: groupPhotoResourceCreate,
+		Delete: groupPhotoResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"group_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			// the photo is not returned by the API, so the configured image stays in state
+			// and changes are detected by comparing the base64 content
+			"image": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func groupPhotoResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	groupId := d.Get("group_object_id").(string)
+
+	imageData, err := base64.StdEncoding.DecodeString(d.Get("image").(string))
+	if err != nil {
+		return fmt.Errorf("unable to decode `image`: expecting a base64-encoded image: %+v", err)
+	}
+
+	contentType := http.DetectContentType(imageData)
+	if _, err := client.SetPhoto(ctx, groupId, contentType, imageData); err != nil {
+		return fmt.Errorf("uploading photo for group with object ID %q: %+v", groupId, err)
+	}
+
+	d.SetId(groupId)
+
+	return groupPhotoResourceRead(d, meta)
+}
+
+func groupPhotoResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	photo, status, err := client.GetPhoto(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Photo for group with object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving photo for group with object ID %q: %+v", d.Id(), err)
+	}
+
+	if photo == nil {
+		log.Printf("[DEBUG] Group with object ID %q no longer has a photo - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_object_id", d.Id())
+
+	return nil
+}
+
+func groupPhotoResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.DeletePhoto(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting photo for group with object ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+// a 1x1 transparent PNG, small enough to inline in the test configuration
+const testAccGroupPhotoImage = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg=="
+
+func TestAccGroupPhoto_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_photo", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckGroupPhotoDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPhoto_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGroupPhotoExists(data.ResourceName),
+				),
+			},
+			// the image content cannot be read back from the API
+			data.ImportStep("image"),
+		},
+	})
+}
+
+func testCheckGroupPhotoExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.GroupsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.GetPhoto(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Photo for group %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving photo for group %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckGroupPhotoDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_group_photo" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.GroupsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.GetPhoto(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving photo for group %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Photo for group %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGroupPhoto_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_group_photo" "test" {
+  group_object_id = azuread_group.test.object_id
+  image           = "%[2]s"
+}
+`, ri, testAccGroupPhotoImage)
+}
@@ -0,0 +1,181 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func invitationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: invitationResourceCreate,
+		Read:   invitationResourceRead,
+		Delete: invitationResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"user_email_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"redirect_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"user_display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"user_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Guest",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Guest",
+					"Member",
+				}, false),
+			},
+
+			"message": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"body": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"user_object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"redeem_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func invitationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.InvitationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.Invitation{
+		InvitedUserEmailAddress: utils.String(d.Get("user_email_address").(string)),
+		InvitedUserType:         utils.String(d.Get("user_type").(string)),
+		InviteRedirectUrl:       utils.String(d.Get("redirect_url").(string)),
+		SendInvitationMessage:   utils.Bool(false),
+	}
+
+	if displayName, ok := d.GetOk("user_display_name"); ok {
+		properties.InvitedUserDisplayName = utils.String(displayName.(string))
+	}
+
+	if message := d.Get("message").([]interface{}); len(message) > 0 {
+		properties.SendInvitationMessage = utils.Bool(true)
+
+		if message[0] != nil {
+			messageInfo := message[0].(map[string]interface{})
+			if body := messageInfo["body"].(string); body != "" {
+				properties.InvitedUserMessageInfo = &graph.InvitedUserMessageInfo{
+					CustomizedMessageBody: utils.String(body),
+				}
+			}
+		}
+	}
+
+	invitation, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Invitation for %q: %+v", d.Get("user_email_address").(string), err)
+	}
+
+	if invitation.ID == nil || *invitation.ID == "" {
+		return fmt.Errorf("Invitation ID is empty or nil")
+	}
+	if invitation.InvitedUser == nil || invitation.InvitedUser.ID == nil || *invitation.InvitedUser.ID == "" {
+		return fmt.Errorf("invited User ID is empty or nil")
+	}
+
+	d.SetId(*invitation.ID)
+	d.Set("user_object_id", *invitation.InvitedUser.ID)
+	d.Set("redeem_url", invitation.InviteRedeemUrl)
+
+	return invitationResourceRead(d, meta)
+}
+
+func invitationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).AadGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	// invitations cannot be retrieved once created, so read the invited guest user
+	// to confirm it still exists
+	objectId := d.Get("user_object_id").(string)
+	if objectId == "" {
+		d.SetId("")
+		return nil
+	}
+
+	user, err := client.Get(ctx, objectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(user.Response) {
+			log.Printf("[DEBUG] Invited User with ID %q was not found - removing from state", objectId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving invited User with ID %q: %+v", objectId, err)
+	}
+
+	d.Set("user_object_id", user.ObjectID)
+
+	return nil
+}
+
+func invitationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).AadGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	// removing the invited guest user also invalidates the invitation
+	objectId := d.Get("user_object_id").(string)
+
+	if _, err := client.Delete(ctx, objectId); err != nil {
+		return fmt.Errorf("deleting invited User with ID %q: %+v", objectId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+func TestAccInvitation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_invitation", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckInvitationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvitation_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckInvitedUserExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "user_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "redeem_url"),
+					resource.TestCheckResourceAttr(data.ResourceName, "user_type", "Guest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInvitation_withMessage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_invitation", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckInvitationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvitation_withMessage(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckInvitedUserExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "user_object_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "user_display_name", fmt.Sprintf("acctestUser-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "message.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckInvitedUserExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).AadGraph.UsersClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		objectId := rs.Primary.Attributes["user_object_id"]
+
+		resp, err := client.Get(ctx, objectId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Invited User %q does not exist", objectId)
+			}
+			return fmt.Errorf("Bad: Get on UsersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckInvitationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_invitation" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).AadGraph.UsersClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		objectId := rs.Primary.Attributes["user_object_id"]
+
+		resp, err := client.Get(ctx, objectId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Invited User still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccInvitation_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_invitation" "test" {
+  user_email_address = "acctestUser.%[1]d@test.example.com"
+  redirect_url       = "https://portal.azure.com"
+}
+`, id)
+}
+
+func testAccInvitation_withMessage(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_invitation" "test" {
+  user_email_address = "acctestUser.%[1]d@test.example.com"
+  user_display_name  = "acctestUser-%[1]d"
+  redirect_url       = "https://portal.azure.com"
+
+  message {
+    body = "Hello there! You have been invited."
+  }
+}
+`, id)
+}
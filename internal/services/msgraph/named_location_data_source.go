@@ -0,0 +1,163 @@
+package msgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func namedLocationData() *schema.Resource {
+	return &schema.Resource{
+		Read: namedLocationDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_ranges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"trusted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"country": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"countries_and_regions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"include_unknown_countries_and_regions": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func namedLocationDataRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	displayName := d.Get("display_name").(string)
+
+	locations, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Named Locations: %+v", err)
+	}
+
+	var matches []graph.NamedLocation
+	for _, v := range locations {
+		if v.DisplayName != nil && strings.EqualFold(*v.DisplayName, displayName) && v.ID != nil {
+			matches = append(matches, v)
+		}
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("found no Named Location with display name %q", displayName)
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("found multiple Named Locations with display name %q", displayName)
+	}
+
+	location := matches[0]
+
+	d.SetId(*location.ID)
+	d.Set("object_id", *location.ID)
+	d.Set("display_name", displayName)
+
+	if err := d.Set("ip", flattenIPNamedLocation(location)); err != nil {
+		return fmt.Errorf("setting `ip`: %+v", err)
+	}
+
+	if err := d.Set("country", flattenCountryNamedLocation(location)); err != nil {
+		return fmt.Errorf("setting `country`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenIPNamedLocation(input graph.NamedLocation) []interface{} {
+	if input.ODataType == nil || *input.ODataType != graph.ODataTypeIPNamedLocation {
+		return []interface{}{}
+	}
+
+	ipRanges := make([]interface{}, 0)
+	if input.IPRanges != nil {
+		for _, v := range *input.IPRanges {
+			if v.CIDRAddress != nil {
+				ipRanges = append(ipRanges, *v.CIDRAddress)
+			}
+		}
+	}
+
+	trusted := false
+	if input.IsTrusted != nil {
+		trusted = *input.IsTrusted
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ip_ranges": ipRanges,
+			"trusted":   trusted,
+		},
+	}
+}
+
+func flattenCountryNamedLocation(input graph.NamedLocation) []interface{} {
+	if input.ODataType == nil || *input.ODataType != graph.ODataTypeCountryNamedLocation {
+		return []interface{}{}
+	}
+
+	countriesAndRegions := make([]interface{}, 0)
+	if input.CountriesAndRegions != nil {
+		for _, v := range *input.CountriesAndRegions {
+			countriesAndRegions = append(countriesAndRegions, v)
+		}
+	}
+
+	includeUnknown := false
+	if input.IncludeUnknownCountriesAndRegions != nil {
+		includeUnknown = *input.IncludeUnknownCountriesAndRegions
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"countries_and_regions":                 countriesAndRegions,
+			"include_unknown_countries_and_regions": includeUnknown,
+		},
+	}
+}
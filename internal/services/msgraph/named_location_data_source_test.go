@@ -0,0 +1,40 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccNamedLocationDataSource_ip(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_named_location", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamedLocationDataSource_ip(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "object_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestNamedLocation-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "ip.0.ip_ranges.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "country.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNamedLocationDataSource_ip(id int) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuread_named_location" "test" {
+  display_name = azuread_named_location.test.display_name
+}
+`, testAccNamedLocation_basic(id))
+}
@@ -0,0 +1,172 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func namedLocationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: namedLocationResourceCreate,
+		Read:   namedLocationResourceRead,
+		Update: namedLocationResourceUpdate,
+		Delete: namedLocationResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"ip_ranges": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"trusted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func namedLocationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.NamedLocation{
+		ODataType:   utils.String(graph.ODataTypeIPNamedLocation),
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		IPRanges:    expandIPNamedLocationIPRanges(d.Get("ip_ranges").([]interface{})),
+		IsTrusted:   utils.Bool(d.Get("trusted").(bool)),
+	}
+
+	location, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Named Location %q: %+v", d.Get("display_name").(string), err)
+	}
+
+	if location.ID == nil || *location.ID == "" {
+		return fmt.Errorf("Named Location ID is empty or nil")
+	}
+
+	d.SetId(*location.ID)
+
+	return namedLocationResourceRead(d, meta)
+}
+
+func namedLocationResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.NamedLocation{
+		ODataType: utils.String(graph.ODataTypeIPNamedLocation),
+	}
+
+	if d.HasChange("display_name") {
+		properties.DisplayName = utils.String(d.Get("display_name").(string))
+	}
+
+	if d.HasChange("ip_ranges") {
+		properties.IPRanges = expandIPNamedLocationIPRanges(d.Get("ip_ranges").([]interface{}))
+	}
+
+	if d.HasChange("trusted") {
+		properties.IsTrusted = utils.Bool(d.Get("trusted").(bool))
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Named Location with ID %q: %+v", d.Id(), err)
+	}
+
+	return namedLocationResourceRead(d, meta)
+}
+
+func namedLocationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	location, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Named Location with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Named Location with ID %q: %+v", d.Id(), err)
+	}
+
+	if location.ODataType == nil || *location.ODataType != graph.ODataTypeIPNamedLocation {
+		return fmt.Errorf("Named Location with ID %q is not an IP Named Location", d.Id())
+	}
+
+	d.Set("display_name", location.DisplayName)
+	d.Set("trusted", location.IsTrusted)
+
+	if err := d.Set("ip_ranges", flattenIPNamedLocationIPRanges(location.IPRanges)); err != nil {
+		return fmt.Errorf("setting `ip_ranges`: %+v", err)
+	}
+
+	return nil
+}
+
+func namedLocationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.NamedLocationsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if _, err := client.Delete(ctx, d.Id()); err != nil {
+		return fmt.Errorf("deleting Named Location with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIPNamedLocationIPRanges(input []interface{}) *[]graph.IPNamedLocationIPRange {
+	result := make([]graph.IPNamedLocationIPRange, 0)
+	for _, v := range input {
+		result = append(result, graph.IPNamedLocationIPRange{
+			CIDRAddress: utils.String(v.(string)),
+		})
+	}
+
+	return &result
+}
+
+func flattenIPNamedLocationIPRanges(input *[]graph.IPNamedLocationIPRange) []interface{} {
+	result := make([]interface{}, 0)
+	if input != nil {
+		for _, v := range *input {
+			if v.CIDRAddress != nil {
+				result = append(result, *v.CIDRAddress)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,147 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccNamedLocation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckNamedLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamedLocation_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNamedLocationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctestNamedLocation-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "ip_ranges.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "trusted", "false"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccNamedLocation_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckNamedLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamedLocation_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNamedLocationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "ip_ranges.#", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "trusted", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccNamedLocation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckNamedLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamedLocation_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNamedLocationExists(data.ResourceName),
+				),
+			},
+			{
+				Config: testAccNamedLocation_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNamedLocationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "ip_ranges.#", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "trusted", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckNamedLocationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.NamedLocationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Named Location %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Named Location %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckNamedLocationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_named_location" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.NamedLocationsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		location, status, err := client.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Named Location still exists:\n%#v", location)
+	}
+
+	return nil
+}
+
+func testAccNamedLocation_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_named_location" "test" {
+  display_name = "acctestNamedLocation-%d"
+  ip_ranges    = ["1.2.3.4/32"]
+}
+`, id)
+}
+
+func testAccNamedLocation_complete(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_named_location" "test" {
+  display_name = "acctestNamedLocation-%d"
+  ip_ranges    = ["1.2.3.4/32", "2001:db8::/64"]
+  trusted      = true
+}
+`, id)
+}
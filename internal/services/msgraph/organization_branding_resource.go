@@ -0,0 +1,230 @@
+package msgraph
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+// organizationBrandingDefaultLocaleId is the locale ID the service uses for the
+// default (locale-independent) branding.
+const organizationBrandingDefaultLocaleId = "0"
+
+func organizationBrandingResource() *schema.Resource {
+	return &schema.Resource{
+		Create: organizationBrandingResourceCreate,
+		Read:   organizationBrandingResourceRead,
+		Update: organizationBrandingResourceUpdate,
+		Delete: organizationBrandingResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the locale of the branding, or %q for the default branding", organizationBrandingDefaultLocaleId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      organizationBrandingDefaultLocaleId,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"background_color": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"sign_in_page_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"username_hint_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"background_image": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"banner_logo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"background_image_relative_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"banner_logo_relative_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func organizationBrandingResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.OrganizationBrandingClient
+	ctx := meta.(*clients.AadClient).StopContext
+	organizationId := meta.(*clients.AadClient).TenantID
+
+	locale := d.Get("locale").(string)
+
+	properties := graph.OrganizationalBranding{
+		BackgroundColor:  utils.String(d.Get("background_color").(string)),
+		SignInPageText:   utils.String(d.Get("sign_in_page_text").(string)),
+		UsernameHintText: utils.String(d.Get("username_hint_text").(string)),
+	}
+
+	if locale == organizationBrandingDefaultLocaleId {
+		// the default branding always exists and only needs updating
+		if _, err := client.UpdateLocalization(ctx, organizationId, locale, properties); err != nil {
+			return fmt.Errorf("creating Organization Branding for locale %q: %+v", locale, err)
+		}
+	} else {
+		properties.ID = utils.String(locale)
+		if _, _, err := client.CreateLocalization(ctx, organizationId, properties); err != nil {
+			return fmt.Errorf("creating Organization Branding for locale %q: %+v", locale, err)
+		}
+	}
+
+	d.SetId(locale)
+
+	if err := organizationBrandingSetImages(d, meta, true); err != nil {
+		return err
+	}
+
+	return organizationBrandingResourceRead(d, meta)
+}
+
+func organizationBrandingResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.OrganizationBrandingClient
+	ctx := meta.(*clients.AadClient).StopContext
+	organizationId := meta.(*clients.AadClient).TenantID
+
+	properties := graph.OrganizationalBranding{
+		BackgroundColor:  utils.String(d.Get("background_color").(string)),
+		SignInPageText:   utils.String(d.Get("sign_in_page_text").(string)),
+		UsernameHintText: utils.String(d.Get("username_hint_text").(string)),
+	}
+
+	if _, err := client.UpdateLocalization(ctx, organizationId, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Organization Branding for locale %q: %+v", d.Id(), err)
+	}
+
+	if err := organizationBrandingSetImages(d, meta, false); err != nil {
+		return err
+	}
+
+	return organizationBrandingResourceRead(d, meta)
+}
+
+// organizationBrandingSetImages uploads the configured branding images, decoding them
+// from base64 and detecting the image content type from the decoded payload.
+func organizationBrandingSetImages(d *schema.ResourceData, meta interface{}, force bool) error {
+	client := meta.(*clients.AadClient).MsGraph.OrganizationBrandingClient
+	ctx := meta.(*clients.AadClient).StopContext
+	organizationId := meta.(*clients.AadClient).TenantID
+
+	images := map[string]string{
+		"background_image": "backgroundImage",
+		"banner_logo":      "bannerLogo",
+	}
+
+	for field, imageName := range images {
+		if !force && !d.HasChange(field) {
+			continue
+		}
+
+		v, ok := d.GetOk(field)
+		if !ok {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return fmt.Errorf("`%s` should be a base64-encoded image: %+v", field, err)
+		}
+
+		contentType := http.DetectContentType(data)
+		if _, err := client.SetImage(ctx, organizationId, d.Id(), imageName, contentType, data); err != nil {
+			return fmt.Errorf("uploading %s for Organization Branding locale %q: %+v", field, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func organizationBrandingResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.OrganizationBrandingClient
+	ctx := meta.(*clients.AadClient).StopContext
+	organizationId := meta.(*clients.AadClient).TenantID
+
+	branding, status, err := client.GetLocalization(ctx, organizationId, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Organization Branding for locale %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Organization Branding for locale %q: %+v", d.Id(), err)
+	}
+
+	d.Set("locale", d.Id())
+	d.Set("background_color", branding.BackgroundColor)
+	d.Set("sign_in_page_text", branding.SignInPageText)
+	d.Set("username_hint_text", branding.UsernameHintText)
+	d.Set("background_image_relative_url", branding.BackgroundImageRelativeUrl)
+	d.Set("banner_logo_relative_url", branding.BannerLogoRelativeUrl)
+
+	// the API does not return the raw image data, so the configured values for
+	// background_image and banner_logo are kept in state
+
+	return nil
+}
+
+func organizationBrandingResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.OrganizationBrandingClient
+	ctx := meta.(*clients.AadClient).StopContext
+	organizationId := meta.(*clients.AadClient).TenantID
+
+	if d.Id() == organizationBrandingDefaultLocaleId {
+		// the default branding cannot be deleted, so its text properties are cleared instead
+		properties := graph.OrganizationalBranding{
+			BackgroundColor:  utils.String(""),
+			SignInPageText:   utils.String(""),
+			UsernameHintText: utils.String(""),
+		}
+		if _, err := client.UpdateLocalization(ctx, organizationId, d.Id(), properties); err != nil {
+			return fmt.Errorf("resetting Organization Branding for locale %q: %+v", d.Id(), err)
+		}
+		return nil
+	}
+
+	if status, err := client.DeleteLocalization(ctx, organizationId, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Organization Branding for locale %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccOrganizationBranding_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_organization_branding", "test")
+
+	// not Parallel, as the default branding is a tenant singleton
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckOrganizationBrandingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationBranding_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOrganizationBrandingExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "background_color", "#00107c"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckOrganizationBrandingExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.OrganizationBrandingClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+		organizationId := acceptance.AzureADProvider.Meta().(*clients.AadClient).TenantID
+
+		if _, _, err := client.GetLocalization(ctx, organizationId, rs.Primary.ID); err != nil {
+			return fmt.Errorf("retrieving Organization Branding for locale %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckOrganizationBrandingDestroy(s *terraform.State) error {
+	// the default branding cannot be deleted, it is reset on destroy, so there is
+	// nothing to verify here
+	return nil
+}
+
+func testAccOrganizationBranding_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_organization_branding" "test" {
+  background_color  = "#00107c"
+  sign_in_page_text = "Acceptance test %[1]d"
+}
+`, id)
+}
@@ -0,0 +1,349 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func permissionGrantPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: permissionGrantPolicyResourceCreate,
+		Read:   permissionGrantPolicyResourceRead,
+		Update: permissionGrantPolicyResourceUpdate,
+		Delete: permissionGrantPolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the ID of the permission grant policy")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"include": permissionGrantConditionSetSchema(),
+			"exclude": permissionGrantConditionSetSchema(),
+		},
+	}
+}
+
+func permissionGrantConditionSetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"permission_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"application",
+						"delegated",
+					}, false),
+				},
+
+				"permission_classification": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "all",
+				},
+
+				"resource_application": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "any",
+				},
+
+				"permissions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"client_application_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"client_application_publisher_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"client_application_tenant_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"client_applications_from_verified_publisher_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func permissionGrantPolicyResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.PermissionGrantPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	name := d.Get("name").(string)
+
+	properties := graph.PermissionGrantPolicy{
+		ID:          utils.String(name),
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		Description: utils.String(d.Get("description").(string)),
+	}
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Permission Grant Policy %q: %+v", name, err)
+	}
+	if policy == nil || policy.ID == nil || *policy.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Permission Grant Policy %q", name)
+	}
+
+	d.SetId(*policy.ID)
+
+	for _, raw := range d.Get("include").([]interface{}) {
+		if _, err := client.AddConditionSet(ctx, d.Id(), "includes", expandPermissionGrantConditionSet(raw)); err != nil {
+			return fmt.Errorf("adding include condition set to Permission Grant Policy %q: %+v", d.Id(), err)
+		}
+	}
+
+	for _, raw := range d.Get("exclude").([]interface{}) {
+		if _, err := client.AddConditionSet(ctx, d.Id(), "excludes", expandPermissionGrantConditionSet(raw)); err != nil {
+			return fmt.Errorf("adding exclude condition set to Permission Grant Policy %q: %+v", d.Id(), err)
+		}
+	}
+
+	return permissionGrantPolicyResourceRead(d, meta)
+}
+
+func permissionGrantPolicyResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.PermissionGrantPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.PermissionGrantPolicy{
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		Description: utils.String(d.Get("description").(string)),
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Permission Grant Policy %q: %+v", d.Id(), err)
+	}
+
+	// condition sets have no natural key for diffing, so changed lists are replaced wholesale
+	for _, listName := range []string{"includes", "excludes"} {
+		field := "include"
+		if listName == "excludes" {
+			field = "exclude"
+		}
+
+		if !d.HasChange(field) {
+			continue
+		}
+
+		existing, _, err := client.ListConditionSets(ctx, d.Id(), listName)
+		if err != nil {
+			return fmt.Errorf("listing %s condition sets for Permission Grant Policy %q: %+v", listName, d.Id(), err)
+		}
+
+		for _, conditionSet := range existing {
+			if conditionSet.ID == nil {
+				continue
+			}
+			if _, err := client.RemoveConditionSet(ctx, d.Id(), listName, *conditionSet.ID); err != nil {
+				return fmt.Errorf("removing condition set %q from Permission Grant Policy %q: %+v", *conditionSet.ID, d.Id(), err)
+			}
+		}
+
+		for _, raw := range d.Get(field).([]interface{}) {
+			if _, err := client.AddConditionSet(ctx, d.Id(), listName, expandPermissionGrantConditionSet(raw)); err != nil {
+				return fmt.Errorf("adding condition set to Permission Grant Policy %q: %+v", d.Id(), err)
+			}
+		}
+	}
+
+	return permissionGrantPolicyResourceRead(d, meta)
+}
+
+func permissionGrantPolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.PermissionGrantPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Permission Grant Policy %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Permission Grant Policy %q: %+v", d.Id(), err)
+	}
+
+	d.Set("name", policy.ID)
+	d.Set("display_name", policy.DisplayName)
+	d.Set("description", policy.Description)
+
+	includes, _, err := client.ListConditionSets(ctx, d.Id(), "includes")
+	if err != nil {
+		return fmt.Errorf("listing includes condition sets for Permission Grant Policy %q: %+v", d.Id(), err)
+	}
+	d.Set("include", flattenPermissionGrantConditionSets(includes))
+
+	excludes, _, err := client.ListConditionSets(ctx, d.Id(), "excludes")
+	if err != nil {
+		return fmt.Errorf("listing excludes condition sets for Permission Grant Policy %q: %+v", d.Id(), err)
+	}
+	d.Set("exclude", flattenPermissionGrantConditionSets(excludes))
+
+	return nil
+}
+
+func permissionGrantPolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.PermissionGrantPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Permission Grant Policy %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandPermissionGrantConditionSet(raw interface{}) graph.PermissionGrantConditionSet {
+	conditionSet := raw.(map[string]interface{})
+
+	result := graph.PermissionGrantConditionSet{
+		ClientApplicationsFromVerifiedPublisherOnly: utils.Bool(conditionSet["client_applications_from_verified_publisher_only"].(bool)),
+		PermissionClassification:                    utils.String(conditionSet["permission_classification"].(string)),
+		PermissionType:                              utils.String(conditionSet["permission_type"].(string)),
+		ResourceApplication:                         utils.String(conditionSet["resource_application"].(string)),
+	}
+
+	if permissions := conditionSet["permissions"].([]interface{}); len(permissions) > 0 {
+		result.Permissions = tf.ExpandStringSlicePtr(permissions)
+	}
+
+	if clientApplicationIds := conditionSet["client_application_ids"].([]interface{}); len(clientApplicationIds) > 0 {
+		result.ClientApplicationIds = tf.ExpandStringSlicePtr(clientApplicationIds)
+	}
+
+	if publisherIds := conditionSet["client_application_publisher_ids"].([]interface{}); len(publisherIds) > 0 {
+		result.ClientApplicationPublisherIds = tf.ExpandStringSlicePtr(publisherIds)
+	}
+
+	if tenantIds := conditionSet["client_application_tenant_ids"].([]interface{}); len(tenantIds) > 0 {
+		result.ClientApplicationTenantIds = tf.ExpandStringSlicePtr(tenantIds)
+	}
+
+	return result
+}
+
+func flattenPermissionGrantConditionSets(conditionSets []graph.PermissionGrantConditionSet) []interface{} {
+	result := make([]interface{}, 0)
+	for _, conditionSet := range conditionSets {
+		permissionType := ""
+		if conditionSet.PermissionType != nil {
+			permissionType = *conditionSet.PermissionType
+		}
+
+		permissionClassification := ""
+		if conditionSet.PermissionClassification != nil {
+			permissionClassification = *conditionSet.PermissionClassification
+		}
+
+		resourceApplication := ""
+		if conditionSet.ResourceApplication != nil {
+			resourceApplication = *conditionSet.ResourceApplication
+		}
+
+		verifiedPublisherOnly := false
+		if conditionSet.ClientApplicationsFromVerifiedPublisherOnly != nil {
+			verifiedPublisherOnly = *conditionSet.ClientApplicationsFromVerifiedPublisherOnly
+		}
+
+		permissions := make([]string, 0)
+		if conditionSet.Permissions != nil {
+			permissions = *conditionSet.Permissions
+		}
+
+		clientApplicationIds := make([]string, 0)
+		if conditionSet.ClientApplicationIds != nil {
+			clientApplicationIds = *conditionSet.ClientApplicationIds
+		}
+
+		publisherIds := make([]string, 0)
+		if conditionSet.ClientApplicationPublisherIds != nil {
+			publisherIds = *conditionSet.ClientApplicationPublisherIds
+		}
+
+		tenantIds := make([]string, 0)
+		if conditionSet.ClientApplicationTenantIds != nil {
+			tenantIds = *conditionSet.ClientApplicationTenantIds
+		}
+
+		result = append(result, map[string]interface{}{
+			"permission_type":                                  permissionType,
+			"permission_classification":                        permissionClassification,
+			"resource_application":                             resourceApplication,
+			"permissions":                                      permissions,
+			"client_application_ids":                           clientApplicationIds,
+			"client_application_publisher_ids":                 publisherIds,
+			"client_application_tenant_ids":                    tenantIds,
+			"client_applications_from_verified_publisher_only": verifiedPublisherOnly,
+		})
+	}
+	return result
+}
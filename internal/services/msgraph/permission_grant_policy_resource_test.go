@@ -0,0 +1,139 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccPermissionGrantPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_permission_grant_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckPermissionGrantPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionGrantPolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPermissionGrantPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "name", fmt.Sprintf("acctest-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "include.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "include.0.permission_type", "delegated"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccPermissionGrantPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_permission_grant_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckPermissionGrantPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionGrantPolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPermissionGrantPolicyExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccPermissionGrantPolicy_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPermissionGrantPolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "exclude.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "include.0.permission_classification", "low"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckPermissionGrantPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.PermissionGrantPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Permission Grant Policy %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Permission Grant Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckPermissionGrantPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_permission_grant_policy" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.PermissionGrantPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving Permission Grant Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Permission Grant Policy %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPermissionGrantPolicy_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_permission_grant_policy" "test" {
+  name         = "acctest-%[1]d"
+  display_name = "acctest-%[1]d"
+
+  include {
+    permission_type = "delegated"
+  }
+}
+`, ri)
+}
+
+func testAccPermissionGrantPolicy_complete(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_permission_grant_policy" "test" {
+  name         = "acctest-%[1]d"
+  display_name = "acctest-updated-%[1]d"
+  description  = "Acceptance test permission grant policy"
+
+  include {
+    permission_type           = "delegated"
+    permission_classification = "low"
+  }
+
+  exclude {
+    permission_type                                  = "application"
+    client_applications_from_verified_publisher_only = true
+  }
+}
+`, ri)
+}
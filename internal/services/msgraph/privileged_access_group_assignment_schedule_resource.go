@@ -0,0 +1,174 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func privilegedAccessGroupAssignmentScheduleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: privilegedAccessGroupAssignmentScheduleResourceCreate,
+		Read:   privilegedAccessGroupAssignmentScheduleResourceRead,
+		Delete: privilegedAccessGroupAssignmentScheduleResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID is blank")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"group_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"assignment_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					graph.PrivilegedAccessGroupAccessIdMember,
+					graph.PrivilegedAccessGroupAccessIdOwner,
+				}, false),
+			},
+
+			"justification": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"expiration_date_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+
+			"start_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func privilegedAccessGroupAssignmentScheduleResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupAssignmentScheduleRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	expiration := graph.RoleScheduleExpiration{
+		Type: utils.String(graph.RoleScheduleExpirationTypeNoExpiration),
+	}
+	if endDateTime, ok := d.GetOk("expiration_date_time"); ok {
+		expiration.Type = utils.String(graph.RoleScheduleExpirationTypeAfterDateTime)
+		expiration.EndDateTime = utils.String(endDateTime.(string))
+	}
+
+	properties := graph.PrivilegedAccessGroupScheduleRequest{
+		AccessId:    utils.String(d.Get("assignment_type").(string)),
+		Action:      utils.String(graph.RoleScheduleRequestActionAdminAssign),
+		GroupId:     utils.String(d.Get("group_object_id").(string)),
+		PrincipalId: utils.String(d.Get("principal_object_id").(string)),
+		ScheduleInfo: &graph.RoleScheduleInfo{
+			Expiration: &expiration,
+		},
+	}
+
+	if justification, ok := d.GetOk("justification"); ok {
+		properties.Justification = utils.String(justification.(string))
+	}
+
+	request, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Privileged Access Group Assignment Schedule: %+v", err)
+	}
+
+	if request.ID == nil || *request.ID == "" {
+		return fmt.Errorf("Privileged Access Group Assignment Schedule ID is empty or nil")
+	}
+
+	d.SetId(*request.ID)
+
+	return privilegedAccessGroupAssignmentScheduleResourceRead(d, meta)
+}
+
+func privilegedAccessGroupAssignmentScheduleResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupAssignmentScheduleRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	request, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Privileged Access Group Assignment Schedule with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Privileged Access Group Assignment Schedule with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("group_object_id", request.GroupId)
+	d.Set("principal_object_id", request.PrincipalId)
+	d.Set("assignment_type", request.AccessId)
+	d.Set("justification", request.Justification)
+	d.Set("status", request.Status)
+
+	if request.ScheduleInfo != nil {
+		d.Set("start_date_time", request.ScheduleInfo.StartDateTime)
+
+		if request.ScheduleInfo.Expiration != nil {
+			d.Set("expiration_date_time", request.ScheduleInfo.Expiration.EndDateTime)
+		}
+	}
+
+	return nil
+}
+
+func privilegedAccessGroupAssignmentScheduleResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.GroupAssignmentScheduleRequestsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	// assignment schedules cannot be deleted directly; the assignment is revoked by
+	// submitting an adminRemove request for the same principal and group
+	properties := graph.PrivilegedAccessGroupScheduleRequest{
+		AccessId:      utils.String(d.Get("assignment_type").(string)),
+		Action:        utils.String(graph.RoleScheduleRequestActionAdminRemove),
+		GroupId:       utils.String(d.Get("group_object_id").(string)),
+		Justification: utils.String("Removed by Terraform"),
+		PrincipalId:   utils.String(d.Get("principal_object_id").(string)),
+	}
+
+	if _, _, err := client.Create(ctx, properties); err != nil {
+		return fmt.Errorf("revoking Privileged Access Group Assignment Schedule with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccPrivilegedAccessGroupEligibilitySchedule_member(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_privileged_access_group_eligibility_schedule", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivilegedAccessGroupEligibilitySchedule_member(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "group_object_id"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "principal_object_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "assignment_type", "member"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrivilegedAccessGroupEligibilitySchedule_member(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_privileged_access_group_eligibility_schedule" "test" {
+  group_object_id     = azuread_group.test.id
+  principal_object_id = azuread_user.test.id
+  assignment_type     = "member"
+  justification       = "acceptance testing"
+}
+`, id, password)
+}
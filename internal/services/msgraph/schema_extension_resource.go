@@ -0,0 +1,242 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func schemaExtensionResource() *schema.Resource {
+	return &schema.Resource{
+		Create: schemaExtensionResourceCreate,
+		Read:   schemaExtensionResourceRead,
+		Update: schemaExtensionResourceUpdate,
+		Delete: schemaExtensionResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID should be the ID of the schema extension")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			// the API derives the final ID from this name by prepending a random
+			// `extXXXXXXXX_` qualifier, unless a verified domain name is included
+			"extension_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"target_types": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"property": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								graph.SchemaExtensionPropertyTypeBinary,
+								graph.SchemaExtensionPropertyTypeBoolean,
+								graph.SchemaExtensionPropertyTypeDateTime,
+								graph.SchemaExtensionPropertyTypeInteger,
+								graph.SchemaExtensionPropertyTypeString,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  graph.SchemaExtensionStatusInDevelopment,
+				ValidateFunc: validation.StringInSlice([]string{
+					graph.SchemaExtensionStatusAvailable,
+					graph.SchemaExtensionStatusDeprecated,
+					graph.SchemaExtensionStatusInDevelopment,
+				}, false),
+			},
+
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func schemaExtensionResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SchemaExtensionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	extensionName := d.Get("extension_name").(string)
+
+	properties := graph.SchemaExtension{
+		ID:          utils.String(extensionName),
+		Description: utils.String(d.Get("description").(string)),
+		Properties:  expandSchemaExtensionProperties(d.Get("property").([]interface{})),
+		TargetTypes: tf.ExpandStringSlicePtr(d.Get("target_types").([]interface{})),
+	}
+
+	extension, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating schema extension %q: %+v", extensionName, err)
+	}
+
+	if extension.ID == nil {
+		return fmt.Errorf("nil ID returned for schema extension %q", extensionName)
+	}
+
+	d.SetId(*extension.ID)
+
+	// schema extensions start out as InDevelopment and must transition to
+	// Available with a separate update
+	if status := d.Get("status").(string); status != graph.SchemaExtensionStatusInDevelopment {
+		patch := graph.SchemaExtension{
+			Status: utils.String(status),
+		}
+		if _, err := client.Update(ctx, d.Id(), patch); err != nil {
+			return fmt.Errorf("setting status %q for schema extension with ID %q: %+v", status, d.Id(), err)
+		}
+	}
+
+	return schemaExtensionResourceRead(d, meta)
+}
+
+func schemaExtensionResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SchemaExtensionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.SchemaExtension{}
+
+	if d.HasChange("description") {
+		properties.Description = utils.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("property") {
+		properties.Properties = expandSchemaExtensionProperties(d.Get("property").([]interface{}))
+	}
+
+	if d.HasChange("status") {
+		properties.Status = utils.String(d.Get("status").(string))
+	}
+
+	if d.HasChange("target_types") {
+		properties.TargetTypes = tf.ExpandStringSlicePtr(d.Get("target_types").([]interface{}))
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating schema extension with ID %q: %+v", d.Id(), err)
+	}
+
+	return schemaExtensionResourceRead(d, meta)
+}
+
+func schemaExtensionResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SchemaExtensionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	extension, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Schema extension with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving schema extension with ID %q: %+v", d.Id(), err)
+	}
+
+	d.Set("description", extension.Description)
+	d.Set("owner", extension.Owner)
+	d.Set("property", flattenSchemaExtensionProperties(extension.Properties))
+	d.Set("status", extension.Status)
+
+	if extension.TargetTypes != nil {
+		d.Set("target_types", *extension.TargetTypes)
+	}
+
+	if _, ok := d.GetOk("extension_name"); !ok {
+		// the original name is not returned by the API, so fall back to the full ID on import
+		d.Set("extension_name", d.Id())
+	}
+
+	return nil
+}
+
+func schemaExtensionResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SchemaExtensionsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting schema extension with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func expandSchemaExtensionProperties(in []interface{}) *[]graph.SchemaExtensionProperty {
+	result := make([]graph.SchemaExtensionProperty, 0, len(in))
+
+	for _, raw := range in {
+		property := raw.(map[string]interface{})
+		result = append(result, graph.SchemaExtensionProperty{
+			Name: utils.String(property["name"].(string)),
+			Type: utils.String(property["type"].(string)),
+		})
+	}
+
+	return &result
+}
+
+func flattenSchemaExtensionProperties(in *[]graph.SchemaExtensionProperty) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, property := range *in {
+		result = append(result, map[string]interface{}{
+			"name": property.Name,
+			"type": property.Type,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,134 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccSchemaExtension_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_schema_extension", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckSchemaExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaExtension_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSchemaExtensionExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "status", "InDevelopment"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "owner"),
+				),
+			},
+			data.ImportStep("extension_name"),
+		},
+	})
+}
+
+func TestAccSchemaExtension_statusTransition(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_schema_extension", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckSchemaExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaExtension_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSchemaExtensionExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "status", "InDevelopment"),
+				),
+			},
+			{
+				Config: testAccSchemaExtension_available(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSchemaExtensionExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "status", "Available"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSchemaExtensionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.SchemaExtensionsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Schema extension %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving schema extension %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckSchemaExtensionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_schema_extension" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.SchemaExtensionsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving schema extension %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Schema extension %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSchemaExtension_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_schema_extension" "test" {
+  extension_name = "acctest%[1]d"
+  description    = "Acceptance test schema extension"
+  target_types   = ["User"]
+
+  property {
+    name = "costCenter"
+    type = "String"
+  }
+}
+`, ri)
+}
+
+func testAccSchemaExtension_available(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_schema_extension" "test" {
+  extension_name = "acctest%[1]d"
+  description    = "Acceptance test schema extension"
+  target_types   = ["User"]
+  status         = "Available"
+
+  property {
+    name = "costCenter"
+    type = "String"
+  }
+}
+`, ri)
+}
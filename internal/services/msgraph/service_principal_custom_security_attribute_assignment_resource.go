@@ -0,0 +1,197 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func servicePrincipalCustomSecurityAttributeAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: servicePrincipalCustomSecurityAttributeAssignmentResourceCreate,
+		Read:   servicePrincipalCustomSecurityAttributeAssignmentResourceRead,
+		Update: servicePrincipalCustomSecurityAttributeAssignmentResourceUpdate,
+		Delete: servicePrincipalCustomSecurityAttributeAssignmentResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseCustomSecurityAttributeAssignmentId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"attribute_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"attribute_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "values"},
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"values": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "values"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func servicePrincipalCustomSecurityAttributeAssignmentResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	servicePrincipalId := d.Get("service_principal_object_id").(string)
+	definitionId := fmt.Sprintf("%s_%s", d.Get("attribute_set_name").(string), d.Get("attribute_name").(string))
+
+	definition, _, err := client.GetDefinition(ctx, definitionId)
+	if err != nil {
+		return fmt.Errorf("retrieving Custom Security Attribute Definition %q: %+v", definitionId, err)
+	}
+
+	attributes, err := expandCustomSecurityAttributeAssignment(d, definition)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.SetServicePrincipalAttributes(ctx, servicePrincipalId, attributes); err != nil {
+		return fmt.Errorf("assigning Custom Security Attribute %q for Service Principal %q: %+v", definitionId, servicePrincipalId, err)
+	}
+
+	d.SetId(graph.CustomSecurityAttributeAssignmentIdFrom(servicePrincipalId, definitionId).String())
+
+	return servicePrincipalCustomSecurityAttributeAssignmentResourceRead(d, meta)
+}
+
+func servicePrincipalCustomSecurityAttributeAssignmentResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseCustomSecurityAttributeAssignmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	definition, _, err := client.GetDefinition(ctx, id.DefinitionId)
+	if err != nil {
+		return fmt.Errorf("retrieving Custom Security Attribute Definition %q: %+v", id.DefinitionId, err)
+	}
+
+	attributes, err := expandCustomSecurityAttributeAssignment(d, definition)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.SetServicePrincipalAttributes(ctx, id.ObjectId, attributes); err != nil {
+		return fmt.Errorf("updating Custom Security Attribute %q for Service Principal %q: %+v", id.DefinitionId, id.ObjectId, err)
+	}
+
+	return servicePrincipalCustomSecurityAttributeAssignmentResourceRead(d, meta)
+}
+
+func servicePrincipalCustomSecurityAttributeAssignmentResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseCustomSecurityAttributeAssignmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(id.DefinitionId, "_", 2)
+	attributeSetName, attributeName := parts[0], parts[1]
+
+	attributes, status, err := client.GetServicePrincipalAttributes(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Service Principal %q was not found - removing assignment from state", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving custom security attributes for Service Principal %q: %+v", id.ObjectId, err)
+	}
+
+	value, ok := attributes[attributeSetName][attributeName]
+	if !ok || value == nil {
+		log.Printf("[DEBUG] Custom Security Attribute %q is not assigned to Service Principal %q - removing from state", id.DefinitionId, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("service_principal_object_id", id.ObjectId)
+	d.Set("attribute_set_name", attributeSetName)
+	d.Set("attribute_name", attributeName)
+
+	if values, ok := value.([]interface{}); ok {
+		flattened := make([]string, 0)
+		for _, v := range values {
+			flattened = append(flattened, customSecurityAttributeValueToString(v))
+		}
+		d.Set("value", "")
+		d.Set("values", flattened)
+	} else {
+		d.Set("value", customSecurityAttributeValueToString(value))
+		d.Set("values", []string{})
+	}
+
+	return nil
+}
+
+func servicePrincipalCustomSecurityAttributeAssignmentResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseCustomSecurityAttributeAssignmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(id.DefinitionId, "_", 2)
+	attributeSetName, attributeName := parts[0], parts[1]
+
+	// assigned values are removed by patching the attribute to null
+	attributes := map[string]interface{}{
+		attributeSetName: map[string]interface{}{
+			"@odata.type": "#Microsoft.DirectoryServices.CustomSecurityAttributeValue",
+			attributeName: nil,
+		},
+	}
+
+	status, err := client.SetServicePrincipalAttributes(ctx, id.ObjectId, attributes)
+	if err != nil && status != http.StatusNotFound {
+		return fmt.Errorf("removing Custom Security Attribute %q from Service Principal %q: %+v", id.DefinitionId, id.ObjectId, err)
+	}
+
+	return nil
+}
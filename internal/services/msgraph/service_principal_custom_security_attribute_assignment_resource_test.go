@@ -0,0 +1,131 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccServicePrincipalCustomSecurityAttributeAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_custom_security_attribute_assignment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckServicePrincipalCustomSecurityAttributeAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalCustomSecurityAttributeAssignment_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckServicePrincipalCustomSecurityAttributeAssignmentExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "attribute_set_name", testAttributeSetName),
+					resource.TestCheckResourceAttr(data.ResourceName, "values.#", "2"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckServicePrincipalCustomSecurityAttributeAssignmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		id, err := graph.ParseCustomSecurityAttributeAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		attributes, status, err := client.GetServicePrincipalAttributes(ctx, id.ObjectId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Service Principal %q does not exist", id.ObjectId)
+			}
+			return fmt.Errorf("retrieving custom security attributes for Service Principal %q: %+v", id.ObjectId, err)
+		}
+
+		for _, values := range attributes {
+			for attributeName, value := range values {
+				if value != nil && fmt.Sprintf("%s_%s", testAttributeSetName, attributeName) == id.DefinitionId {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Custom Security Attribute %q is not assigned to Service Principal %q", id.DefinitionId, id.ObjectId)
+	}
+}
+
+func testCheckServicePrincipalCustomSecurityAttributeAssignmentDestroy(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_service_principal_custom_security_attribute_assignment" {
+			continue
+		}
+
+		id, err := graph.ParseCustomSecurityAttributeAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		attributes, status, err := client.GetServicePrincipalAttributes(ctx, id.ObjectId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				// the service principal itself is gone along with its attribute values
+				continue
+			}
+			return fmt.Errorf("retrieving custom security attributes for Service Principal %q: %+v", id.ObjectId, err)
+		}
+
+		for _, values := range attributes {
+			for attributeName, value := range values {
+				if value != nil && fmt.Sprintf("%s_%s", testAttributeSetName, attributeName) == id.DefinitionId {
+					return fmt.Errorf("Custom Security Attribute %q is still assigned to Service Principal %q", id.DefinitionId, id.ObjectId)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccServicePrincipalCustomSecurityAttributeAssignment_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set_name = "%[2]s"
+  name               = "acctestMultiAttr%[1]d"
+  type               = "String"
+  multi_valued       = true
+}
+
+resource "azuread_service_principal_custom_security_attribute_assignment" "test" {
+  service_principal_object_id = azuread_service_principal.test.object_id
+  attribute_set_name          = azuread_custom_security_attribute_definition.test.attribute_set_name
+  attribute_name              = azuread_custom_security_attribute_definition.test.name
+  values                      = ["alpha", "beta"]
+}
+`, id, testAttributeSetName)
+}
@@ -0,0 +1,173 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+// policyAssignmentCollections maps the configurable policy types to the navigation
+// property used to link them on an application or service principal
+var policyAssignmentCollections = map[string]string{
+	"appManagement":      "appManagementPolicies",
+	"claimsMapping":      "claimsMappingPolicies",
+	"homeRealmDiscovery": "homeRealmDiscoveryPolicies",
+	"tokenIssuance":      "tokenIssuancePolicies",
+	"tokenLifetime":      "tokenLifetimePolicies",
+}
+
+func servicePrincipalPolicyAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: servicePrincipalPolicyAssignmentResourceCreate,
+		Read:   servicePrincipalPolicyAssignmentResourceRead,
+		Delete: servicePrincipalPolicyAssignmentResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParsePolicyAssignmentId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"policy_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"appManagement",
+					"claimsMapping",
+					"homeRealmDiscovery",
+					"tokenIssuance",
+					"tokenLifetime",
+				}, false),
+			},
+
+			"service_principal_object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+				ExactlyOneOf: []string{"application_object_id", "service_principal_object_id"},
+			},
+
+			"application_object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+				ExactlyOneOf: []string{"application_object_id", "service_principal_object_id"},
+			},
+		},
+	}
+}
+
+func servicePrincipalPolicyAssignmentResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.PolicyAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policyId := d.Get("policy_id").(string)
+	policyType := d.Get("policy_type").(string)
+
+	objectType := "servicePrincipals"
+	objectId := d.Get("service_principal_object_id").(string)
+	if objectId == "" {
+		objectType = "applications"
+		objectId = d.Get("application_object_id").(string)
+	}
+
+	id := graph.PolicyAssignmentIdFrom(objectType, objectId, policyType, policyId)
+
+	if _, err := client.Assign(ctx, id.ObjectType, id.ObjectId, policyAssignmentCollections[policyType], id.PolicyId); err != nil {
+		return fmt.Errorf("assigning policy %q to %s %q: %+v", policyId, objectType, objectId, err)
+	}
+
+	d.SetId(id.String())
+
+	return servicePrincipalPolicyAssignmentResourceRead(d, meta)
+}
+
+func servicePrincipalPolicyAssignmentResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.PolicyAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParsePolicyAssignmentId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Policy Assignment ID %q: %+v", d.Id(), err)
+	}
+
+	collection, ok := policyAssignmentCollections[id.PolicyType]
+	if !ok {
+		return fmt.Errorf("unrecognised policy type %q in Policy Assignment ID %q", id.PolicyType, d.Id())
+	}
+
+	policyIds, status, err := client.List(ctx, id.ObjectType, id.ObjectId, collection)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] %s %q was not found - removing assignment from state", id.ObjectType, id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("listing %s policies for %s %q: %+v", id.PolicyType, id.ObjectType, id.ObjectId, err)
+	}
+
+	var assigned bool
+	for _, policyId := range policyIds {
+		if policyId == id.PolicyId {
+			assigned = true
+			break
+		}
+	}
+
+	if !assigned {
+		log.Printf("[DEBUG] Policy %q is no longer assigned to %s %q - removing from state", id.PolicyId, id.ObjectType, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("policy_id", id.PolicyId)
+	d.Set("policy_type", id.PolicyType)
+	if id.ObjectType == "applications" {
+		d.Set("application_object_id", id.ObjectId)
+	} else {
+		d.Set("service_principal_object_id", id.ObjectId)
+	}
+
+	return nil
+}
+
+func servicePrincipalPolicyAssignmentResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.PolicyAssignmentsClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParsePolicyAssignmentId(d.Id())
+	if err != nil {
+		return fmt.Errorf("parsing Policy Assignment ID %q: %+v", d.Id(), err)
+	}
+
+	collection, ok := policyAssignmentCollections[id.PolicyType]
+	if !ok {
+		return fmt.Errorf("unrecognised policy type %q in Policy Assignment ID %q", id.PolicyType, d.Id())
+	}
+
+	if status, err := client.Remove(ctx, id.ObjectType, id.ObjectId, collection, id.PolicyId); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("removing policy %q from %s %q: %+v", id.PolicyId, id.ObjectType, id.ObjectId, err)
+		}
+	}
+
+	return nil
+}
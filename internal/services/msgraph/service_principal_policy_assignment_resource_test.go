@@ -0,0 +1,166 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccServicePrincipalPolicyAssignment_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_policy_assignment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckServicePrincipalPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalPolicyAssignment_servicePrincipal(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckServicePrincipalPolicyAssignmentExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "policy_type", "tokenIssuance"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccServicePrincipalPolicyAssignment_application(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_policy_assignment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckServicePrincipalPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalPolicyAssignment_application(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckServicePrincipalPolicyAssignmentExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckServicePrincipalPolicyAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		id, err := graph.ParsePolicyAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("parsing Policy Assignment ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.PolicyAssignmentsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		policyIds, _, err := client.List(ctx, id.ObjectType, id.ObjectId, id.PolicyType+"Policies")
+		if err != nil {
+			return fmt.Errorf("listing %s policies for %s %q: %+v", id.PolicyType, id.ObjectType, id.ObjectId, err)
+		}
+
+		for _, policyId := range policyIds {
+			if policyId == id.PolicyId {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("policy %q is not assigned to %s %q", id.PolicyId, id.ObjectType, id.ObjectId)
+	}
+}
+
+func testCheckServicePrincipalPolicyAssignmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_service_principal_policy_assignment" {
+			continue
+		}
+
+		id, err := graph.ParsePolicyAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("parsing Policy Assignment ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.PolicyAssignmentsClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		policyIds, status, err := client.List(ctx, id.ObjectType, id.ObjectId, id.PolicyType+"Policies")
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("listing %s policies for %s %q: %+v", id.PolicyType, id.ObjectType, id.ObjectId, err)
+		}
+
+		for _, policyId := range policyIds {
+			if policyId == id.PolicyId {
+				return fmt.Errorf("policy %q is still assigned to %s %q", id.PolicyId, id.ObjectType, id.ObjectId)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccServicePrincipalPolicyAssignment_template(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_token_issuance_policy" "test" {
+  display_name = "acctest-%[1]d"
+
+  definition = [
+    jsonencode({
+      TokenIssuancePolicy = {
+        Version          = 1
+        SigningAlgorithm = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+        TokenResponseSigningPolicy = "TokenOnly"
+        SamlTokenVersion = "2.0"
+      }
+    })
+  ]
+}
+
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+`, ri)
+}
+
+func testAccServicePrincipalPolicyAssignment_servicePrincipal(ri int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_service_principal_policy_assignment" "test" {
+  service_principal_object_id = azuread_service_principal.test.object_id
+  policy_type                 = "tokenIssuance"
+  policy_id                   = azuread_token_issuance_policy.test.id
+}
+`, testAccServicePrincipalPolicyAssignment_template(ri))
+}
+
+func testAccServicePrincipalPolicyAssignment_application(ri int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_policy_assignment" "test" {
+  application_object_id = azuread_application.test.object_id
+  policy_type           = "tokenIssuance"
+  policy_id             = azuread_token_issuance_policy.test.id
+}
+`, testAccServicePrincipalPolicyAssignment_template(ri))
+}
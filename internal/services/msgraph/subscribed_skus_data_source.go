@@ -0,0 +1,132 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func subscribedSkusData() *schema.Resource {
+	return &schema.Resource{
+		Read: subscribedSkusDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"skus": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sku_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"sku_part_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"applies_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"capability_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"consumed_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"enabled_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"suspended_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"warning_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func subscribedSkusDataRead(d *schema.ResourceData, meta interface{}) error {
+	tenantId := meta.(*clients.AadClient).TenantID
+	client := meta.(*clients.AadClient).MsGraph.SubscribedSkusClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	result, err := client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Subscribed SKUs: %+v", err)
+	}
+
+	d.SetId("subscribedSkus-" + tenantId)
+
+	if err = d.Set("skus", flattenSubscribedSkus(result)); err != nil {
+		return fmt.Errorf("setting `skus`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenSubscribedSkus(input []graph.SubscribedSku) []interface{} {
+	skus := make([]interface{}, 0)
+	for _, v := range input {
+		sku := map[string]interface{}{
+			"sku_id":            "",
+			"sku_part_number":   "",
+			"applies_to":        "",
+			"capability_status": "",
+			"consumed_units":    0,
+			"enabled_units":     0,
+			"suspended_units":   0,
+			"warning_units":     0,
+		}
+
+		if v.SkuId != nil {
+			sku["sku_id"] = *v.SkuId
+		}
+		if v.SkuPartNumber != nil {
+			sku["sku_part_number"] = *v.SkuPartNumber
+		}
+		if v.AppliesTo != nil {
+			sku["applies_to"] = *v.AppliesTo
+		}
+		if v.CapabilityStatus != nil {
+			sku["capability_status"] = *v.CapabilityStatus
+		}
+		if v.ConsumedUnits != nil {
+			sku["consumed_units"] = int(*v.ConsumedUnits)
+		}
+		if v.PrepaidUnits != nil {
+			if v.PrepaidUnits.Enabled != nil {
+				sku["enabled_units"] = int(*v.PrepaidUnits.Enabled)
+			}
+			if v.PrepaidUnits.Suspended != nil {
+				sku["suspended_units"] = int(*v.PrepaidUnits.Suspended)
+			}
+			if v.PrepaidUnits.Warning != nil {
+				sku["warning_units"] = int(*v.PrepaidUnits.Warning)
+			}
+		}
+
+		skus = append(skus, sku)
+	}
+
+	return skus
+}
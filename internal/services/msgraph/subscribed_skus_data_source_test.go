@@ -0,0 +1,30 @@
+package msgraph_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccSubscribedSkusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_subscribed_skus", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscribedSkusDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "skus.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSubscribedSkusDataSource_basic = `
+data "azuread_subscribed_skus" "test" {}
+`
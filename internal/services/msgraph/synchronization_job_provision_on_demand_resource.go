@@ -0,0 +1,146 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func synchronizationJobProvisionOnDemandResource() *schema.Resource {
+	return &schema.Resource{
+		Create: synchronizationJobProvisionOnDemandResourceCreate,
+		Read:   synchronizationJobProvisionOnDemandResourceRead,
+		Delete: synchronizationJobProvisionOnDemandResourceDelete,
+
+		// this resource performs a one-shot action, so there is nothing to import
+
+		Schema: map[string]*schema.Schema{
+			"synchronization_job_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := graph.ParseSynchronizationJobId(i.(string)); err != nil {
+						return nil, []error{err}
+					}
+					return nil, nil
+				},
+			},
+
+			"parameter": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"subject": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.UUID,
+									},
+
+									"object_type_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Group",
+											"User",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// arbitrary map of values which, when changed, provisions the subjects again
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func synchronizationJobProvisionOnDemandResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseSynchronizationJobId(d.Get("synchronization_job_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := expandSynchronizationJobParameters(d.Get("parameter").([]interface{}))
+
+	if _, err := client.ProvisionJobOnDemand(ctx, id.ServicePrincipalId, id.JobId, parameters); err != nil {
+		return fmt.Errorf("provisioning on demand through Synchronization Job %q: %+v", id.JobId, err)
+	}
+
+	resourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating resource ID: %+v", err)
+	}
+	d.SetId(resourceId)
+
+	return nil
+}
+
+// synchronizationJobProvisionOnDemandResourceRead is a no-op, since provisioning on
+// demand is a one-shot action with no server-side state to refresh.
+func synchronizationJobProvisionOnDemandResourceRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func synchronizationJobProvisionOnDemandResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func expandSynchronizationJobParameters(in []interface{}) []graph.SynchronizationJobApplicationParameters {
+	result := make([]graph.SynchronizationJobApplicationParameters, 0, len(in))
+	for _, raw := range in {
+		parameter := raw.(map[string]interface{})
+
+		subjects := make([]graph.SynchronizationJobSubject, 0)
+		for _, rawSubject := range parameter["subject"].([]interface{}) {
+			subject := rawSubject.(map[string]interface{})
+			subjects = append(subjects, graph.SynchronizationJobSubject{
+				ObjectId:       utils.String(subject["object_id"].(string)),
+				ObjectTypeName: utils.String(subject["object_type_name"].(string)),
+			})
+		}
+
+		result = append(result, graph.SynchronizationJobApplicationParameters{
+			RuleId:   utils.String(parameter["rule_id"].(string)),
+			Subjects: &subjects,
+		})
+	}
+	return result
+}
@@ -0,0 +1,71 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccSynchronizationJobProvisionOnDemand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job_provision_on_demand", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckSynchronizationJobProvisionOnDemandDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSynchronizationJobProvisionOnDemand_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSynchronizationJobProvisionOnDemandDestroy(s *terraform.State) error {
+	// provisioning on demand is a one-shot action with no server-side state, so there
+	// is nothing to verify here
+	return nil
+}
+
+func testAccSynchronizationJobProvisionOnDemand_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_synchronization_job" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  template_id          = "dataBricks"
+  enabled              = false
+}
+
+resource "azuread_group" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_synchronization_job_provision_on_demand" "test" {
+  synchronization_job_id = azuread_synchronization_job.test.id
+
+  parameter {
+    # the group-to-user provisioning rule from the dataBricks template
+    rule_id = "03f7d90d-bf71-41b1-bda6-aaf0ddbee5d8"
+
+    subject {
+      object_id        = azuread_group.test.id
+      object_type_name = "Group"
+    }
+  }
+}
+`, id)
+}
@@ -0,0 +1,197 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func synchronizationJobResource() *schema.Resource {
+	return &schema.Resource{
+		Create: synchronizationJobResourceCreate,
+		Read:   synchronizationJobResourceRead,
+		Update: synchronizationJobResourceUpdate,
+		Delete: synchronizationJobResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseSynchronizationJobId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"schedule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"interval": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func synchronizationJobResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	servicePrincipalId := d.Get("service_principal_id").(string)
+
+	properties := graph.SynchronizationJob{
+		TemplateId: utils.String(d.Get("template_id").(string)),
+	}
+
+	job, _, err := client.CreateJob(ctx, servicePrincipalId, properties)
+	if err != nil {
+		return fmt.Errorf("creating Synchronization Job for service principal %q: %+v", servicePrincipalId, err)
+	}
+	if job == nil || job.ID == nil || *job.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Synchronization Job")
+	}
+
+	if d.Get("enabled").(bool) {
+		if _, err := client.StartJob(ctx, servicePrincipalId, *job.ID); err != nil {
+			return fmt.Errorf("starting Synchronization Job %q: %+v", *job.ID, err)
+		}
+	}
+
+	d.SetId(graph.SynchronizationJobIdFrom(servicePrincipalId, *job.ID).String())
+
+	return synchronizationJobResourceRead(d, meta)
+}
+
+func synchronizationJobResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseSynchronizationJobId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("enabled") {
+		if d.Get("enabled").(bool) {
+			if _, err := client.StartJob(ctx, id.ServicePrincipalId, id.JobId); err != nil {
+				return fmt.Errorf("starting Synchronization Job %q: %+v", id.JobId, err)
+			}
+		} else {
+			if _, err := client.PauseJob(ctx, id.ServicePrincipalId, id.JobId); err != nil {
+				return fmt.Errorf("pausing Synchronization Job %q: %+v", id.JobId, err)
+			}
+		}
+	}
+
+	return synchronizationJobResourceRead(d, meta)
+}
+
+func synchronizationJobResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseSynchronizationJobId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	job, status, err := client.GetJob(ctx, id.ServicePrincipalId, id.JobId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Synchronization Job %q was not found on service principal %q - removing from state", id.JobId, id.ServicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Synchronization Job %q: %+v", id.JobId, err)
+	}
+
+	d.Set("service_principal_id", id.ServicePrincipalId)
+	d.Set("template_id", job.TemplateId)
+
+	schedule := make([]map[string]interface{}, 0)
+	scheduleState := ""
+	if job.Schedule != nil {
+		expiration := ""
+		if job.Schedule.Expiration != nil {
+			expiration = *job.Schedule.Expiration
+		}
+
+		interval := ""
+		if job.Schedule.Interval != nil {
+			interval = *job.Schedule.Interval
+		}
+
+		if job.Schedule.State != nil {
+			scheduleState = *job.Schedule.State
+		}
+
+		schedule = append(schedule, map[string]interface{}{
+			"expiration": expiration,
+			"interval":   interval,
+			"state":      scheduleState,
+		})
+	}
+	d.Set("schedule", schedule)
+	d.Set("enabled", scheduleState == "Active")
+
+	return nil
+}
+
+func synchronizationJobResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseSynchronizationJobId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if status, err := client.DeleteJob(ctx, id.ServicePrincipalId, id.JobId); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Synchronization Job %q: %+v", id.JobId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccSynchronizationJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckSynchronizationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSynchronizationJob_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSynchronizationJobExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "template_id", "dataBricks"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "schedule.0.interval"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckSynchronizationJobExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		id, err := graph.ParseSynchronizationJobId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.SynchronizationClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.GetJob(ctx, id.ServicePrincipalId, id.JobId); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Synchronization Job %q does not exist on service principal %q", id.JobId, id.ServicePrincipalId)
+			}
+			return fmt.Errorf("retrieving Synchronization Job %q: %+v", id.JobId, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckSynchronizationJobDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_synchronization_job" {
+			continue
+		}
+
+		id, err := graph.ParseSynchronizationJobId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.SynchronizationClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		job, status, err := client.GetJob(ctx, id.ServicePrincipalId, id.JobId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Synchronization Job still exists:\n%#v", job)
+	}
+
+	return nil
+}
+
+func testAccSynchronizationJob_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_synchronization_job" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  template_id          = "dataBricks"
+  enabled              = false
+}
+`, id)
+}
@@ -0,0 +1,100 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func synchronizationSecretResource() *schema.Resource {
+	return &schema.Resource{
+		Create: synchronizationSecretResourceCreateUpdate,
+		Read:   synchronizationSecretResourceRead,
+		Update: synchronizationSecretResourceCreateUpdate,
+		Delete: synchronizationSecretResourceDelete,
+
+		// the API does not support reading secrets back, so this resource cannot be imported
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"credential": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func synchronizationSecretResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	servicePrincipalId := d.Get("service_principal_id").(string)
+
+	if _, err := client.SetSecrets(ctx, servicePrincipalId, expandSynchronizationSecrets(d.Get("credential").([]interface{}))); err != nil {
+		return fmt.Errorf("setting synchronization secrets for service principal %q: %+v", servicePrincipalId, err)
+	}
+
+	d.SetId(servicePrincipalId)
+
+	return synchronizationSecretResourceRead(d, meta)
+}
+
+// synchronizationSecretResourceRead only restores the service principal ID, since the
+// API does not support reading secrets back and the configured values are kept in state.
+func synchronizationSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
+	d.Set("service_principal_id", d.Id())
+
+	return nil
+}
+
+func synchronizationSecretResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.SynchronizationClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if _, err := client.SetSecrets(ctx, d.Id(), []graph.SynchronizationSecretKeyStringValuePair{}); err != nil {
+		return fmt.Errorf("removing synchronization secrets for service principal %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSynchronizationSecrets(in []interface{}) []graph.SynchronizationSecretKeyStringValuePair {
+	result := make([]graph.SynchronizationSecretKeyStringValuePair, 0, len(in))
+	for _, raw := range in {
+		credential := raw.(map[string]interface{})
+		result = append(result, graph.SynchronizationSecretKeyStringValuePair{
+			Key:   utils.String(credential["key"].(string)),
+			Value: utils.String(credential["value"].(string)),
+		})
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+)
+
+func TestAccSynchronizationSecret_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_secret", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckSynchronizationSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSynchronizationSecret_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "service_principal_id"),
+					resource.TestCheckResourceAttr(data.ResourceName, "credential.#", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "credential.0.key", "BaseAddress"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSynchronizationSecretDestroy(s *terraform.State) error {
+	// secrets cannot be read back from the API, so there is nothing to verify here
+	return nil
+}
+
+func testAccSynchronizationSecret_basic(id int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_synchronization_secret" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+
+  credential {
+    key   = "BaseAddress"
+    value = "https://example.net/scim"
+  }
+
+  credential {
+    key   = "SecretToken"
+    value = "acctest-token-%[1]d"
+  }
+}
+`, id)
+}
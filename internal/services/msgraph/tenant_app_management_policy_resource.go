@@ -0,0 +1,130 @@
+package msgraph
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+// tenantAppManagementPolicyId is the fixed ID of the tenant-wide default app
+// management policy singleton.
+const tenantAppManagementPolicyId = "defaultAppManagementPolicy"
+
+func tenantAppManagementPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: tenantAppManagementPolicyResourceCreateUpdate,
+		Read:   tenantAppManagementPolicyResourceRead,
+		Update: tenantAppManagementPolicyResourceCreateUpdate,
+		Delete: tenantAppManagementPolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != tenantAppManagementPolicyId {
+				return fmt.Errorf("specified ID (%q) must be %q", id, tenantAppManagementPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"application_restrictions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     appManagementRestrictionsSchema(),
+			},
+
+			"service_principal_restrictions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     appManagementRestrictionsSchema(),
+			},
+		},
+	}
+}
+
+func tenantAppManagementPolicyResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.TenantAppManagementPolicy{
+		IsEnabled:                    utils.Bool(d.Get("enabled").(bool)),
+		ApplicationRestrictions:      expandAppManagementRestrictions(d.Get("application_restrictions").([]interface{})),
+		ServicePrincipalRestrictions: expandAppManagementRestrictions(d.Get("service_principal_restrictions").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		properties.DisplayName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.UpdateTenantDefault(ctx, properties); err != nil {
+		return fmt.Errorf("updating tenant default app management policy: %+v", err)
+	}
+
+	d.SetId(tenantAppManagementPolicyId)
+
+	return tenantAppManagementPolicyResourceRead(d, meta)
+}
+
+func tenantAppManagementPolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, _, err := client.GetTenantDefault(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving tenant default app management policy: %+v", err)
+	}
+
+	d.Set("enabled", policy.IsEnabled)
+	d.Set("display_name", policy.DisplayName)
+	d.Set("description", policy.Description)
+	d.Set("application_restrictions", flattenAppManagementRestrictions(policy.ApplicationRestrictions))
+	d.Set("service_principal_restrictions", flattenAppManagementRestrictions(policy.ServicePrincipalRestrictions))
+
+	return nil
+}
+
+// tenantAppManagementPolicyResourceDelete disables the policy and clears its
+// restrictions, since the underlying policy is a tenant singleton which cannot
+// be deleted.
+func tenantAppManagementPolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.TenantAppManagementPolicy{
+		IsEnabled:                    utils.Bool(false),
+		ApplicationRestrictions:      expandAppManagementRestrictions(nil),
+		ServicePrincipalRestrictions: expandAppManagementRestrictions(nil),
+	}
+
+	if _, err := client.UpdateTenantDefault(ctx, properties); err != nil {
+		return fmt.Errorf("resetting tenant default app management policy: %+v", err)
+	}
+
+	return nil
+}
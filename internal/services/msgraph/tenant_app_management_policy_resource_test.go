@@ -0,0 +1,70 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccTenantAppManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_tenant_app_management_policy", "test")
+
+	// not Parallel, as the default app management policy is a tenant singleton
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckTenantAppManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantAppManagementPolicy_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(data.ResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(data.ResourceName, "application_restrictions.0.password_credential.0.restriction_type", "passwordLifetime"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckTenantAppManagementPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_tenant_app_management_policy" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.AppManagementPoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		policy, _, err := client.GetTenantDefault(ctx)
+		if err != nil {
+			return fmt.Errorf("retrieving tenant default app management policy: %+v", err)
+		}
+
+		if policy.IsEnabled != nil && *policy.IsEnabled {
+			return fmt.Errorf("tenant default app management policy is still enabled")
+		}
+	}
+
+	return nil
+}
+
+func testAccTenantAppManagementPolicy_basic() string {
+	return `
+resource "azuread_tenant_app_management_policy" "test" {
+  enabled = true
+
+  application_restrictions {
+    password_credential {
+      restriction_type = "passwordLifetime"
+      max_lifetime     = "P90D"
+    }
+  }
+}
+`
+}
@@ -0,0 +1,137 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func tokenIssuancePolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: tokenIssuancePolicyResourceCreate,
+		Read:   tokenIssuancePolicyResourceRead,
+		Update: tokenIssuancePolicyResourceUpdate,
+		Delete: tokenIssuancePolicyResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// each entry is a JSON document describing the token signing and format overrides
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+
+			"is_organization_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func tokenIssuancePolicyResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.TokenIssuancePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.TokenIssuancePolicy{
+		Definition:            tf.ExpandStringSlicePtr(d.Get("definition").([]interface{})),
+		DisplayName:           utils.String(d.Get("display_name").(string)),
+		IsOrganizationDefault: utils.Bool(d.Get("is_organization_default").(bool)),
+	}
+
+	policy, _, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("creating Token Issuance Policy %q: %+v", d.Get("display_name").(string), err)
+	}
+	if policy == nil || policy.ID == nil || *policy.ID == "" {
+		return fmt.Errorf("nil/blank ID returned for Token Issuance Policy %q", d.Get("display_name").(string))
+	}
+
+	d.SetId(*policy.ID)
+
+	return tokenIssuancePolicyResourceRead(d, meta)
+}
+
+func tokenIssuancePolicyResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.TokenIssuancePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	properties := graph.TokenIssuancePolicy{
+		Definition:            tf.ExpandStringSlicePtr(d.Get("definition").([]interface{})),
+		DisplayName:           utils.String(d.Get("display_name").(string)),
+		IsOrganizationDefault: utils.Bool(d.Get("is_organization_default").(bool)),
+	}
+
+	if _, err := client.Update(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("updating Token Issuance Policy %q: %+v", d.Id(), err)
+	}
+
+	return tokenIssuancePolicyResourceRead(d, meta)
+}
+
+func tokenIssuancePolicyResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.TokenIssuancePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	policy, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Token Issuance Policy %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Token Issuance Policy %q: %+v", d.Id(), err)
+	}
+
+	d.Set("display_name", policy.DisplayName)
+	d.Set("is_organization_default", policy.IsOrganizationDefault)
+
+	definition := make([]string, 0)
+	if policy.Definition != nil {
+		definition = *policy.Definition
+	}
+	d.Set("definition", definition)
+
+	return nil
+}
+
+func tokenIssuancePolicyResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.TokenIssuancePoliciesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	if status, err := client.Delete(ctx, d.Id()); err != nil {
+		if status != http.StatusNotFound {
+			return fmt.Errorf("deleting Token Issuance Policy %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
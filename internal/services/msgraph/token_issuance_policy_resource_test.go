@@ -0,0 +1,142 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+)
+
+func TestAccTokenIssuancePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_token_issuance_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckTokenIssuancePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTokenIssuancePolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTokenIssuancePolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctest-%d", data.RandomInteger)),
+					resource.TestCheckResourceAttr(data.ResourceName, "definition.#", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccTokenIssuancePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_token_issuance_policy", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckTokenIssuancePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTokenIssuancePolicy_basic(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTokenIssuancePolicyExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccTokenIssuancePolicy_complete(data.RandomInteger),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTokenIssuancePolicyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "display_name", fmt.Sprintf("acctest-updated-%d", data.RandomInteger)),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckTokenIssuancePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", resourceName)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.TokenIssuancePoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Token Issuance Policy %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("retrieving Token Issuance Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckTokenIssuancePolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_token_issuance_policy" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.TokenIssuancePoliciesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		if _, status, err := client.Get(ctx, rs.Primary.ID); err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("retrieving Token Issuance Policy %q: %+v", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("Token Issuance Policy %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTokenIssuancePolicy_basic(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_token_issuance_policy" "test" {
+  display_name = "acctest-%[1]d"
+
+  definition = [
+    jsonencode({
+      TokenIssuancePolicy = {
+        Version          = 1
+        SigningAlgorithm = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+        TokenResponseSigningPolicy = "TokenOnly"
+        SamlTokenVersion = "2.0"
+      }
+    })
+  ]
+}
+`, ri)
+}
+
+func testAccTokenIssuancePolicy_complete(ri int) string {
+	return fmt.Sprintf(`
+resource "azuread_token_issuance_policy" "test" {
+  display_name = "acctest-updated-%[1]d"
+
+  definition = [
+    jsonencode({
+      TokenIssuancePolicy = {
+        Version          = 1
+        SigningAlgorithm = "http://www.w3.org/2000/09/xmldsig#rsa-sha1"
+        TokenResponseSigningPolicy = "ResponseAndToken"
+        SamlTokenVersion = "1.1"
+      }
+    })
+  ]
+}
+`, ri)
+}
@@ -0,0 +1,143 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func userAuthenticationMethodPhoneResource() *schema.Resource {
+	return &schema.Resource{
+		Create: userAuthenticationMethodPhoneResourceCreate,
+		Read:   userAuthenticationMethodPhoneResourceRead,
+		Update: userAuthenticationMethodPhoneResourceUpdate,
+		Delete: userAuthenticationMethodPhoneResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseUserPhoneMethodId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"user_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// a user can only have one phone method of each type, so changing the
+			// type means registering a new method
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  graph.PhoneMethodTypeMobile,
+				ValidateFunc: validation.StringInSlice([]string{
+					graph.PhoneMethodTypeAlternateMobile,
+					graph.PhoneMethodTypeMobile,
+					graph.PhoneMethodTypeOffice,
+				}, false),
+			},
+		},
+	}
+}
+
+func userAuthenticationMethodPhoneResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	userId := d.Get("user_object_id").(string)
+
+	properties := graph.PhoneAuthenticationMethod{
+		PhoneNumber: utils.String(d.Get("phone_number").(string)),
+		PhoneType:   utils.String(d.Get("type").(string)),
+	}
+
+	method, _, err := client.CreatePhoneMethod(ctx, userId, properties)
+	if err != nil {
+		return fmt.Errorf("registering phone authentication method for User with ID %q: %+v", userId, err)
+	}
+	if method.ID == nil {
+		return fmt.Errorf("nil method ID returned when registering phone authentication method for User with ID %q", userId)
+	}
+
+	d.SetId(graph.UserPhoneMethodIdFrom(userId, *method.ID).String())
+
+	return userAuthenticationMethodPhoneResourceRead(d, meta)
+}
+
+func userAuthenticationMethodPhoneResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseUserPhoneMethodId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	properties := graph.PhoneAuthenticationMethod{
+		PhoneNumber: utils.String(d.Get("phone_number").(string)),
+	}
+
+	if _, err := client.UpdatePhoneMethod(ctx, id.UserId, id.MethodId, properties); err != nil {
+		return fmt.Errorf("updating phone authentication method %q for User with ID %q: %+v", id.MethodId, id.UserId, err)
+	}
+
+	return userAuthenticationMethodPhoneResourceRead(d, meta)
+}
+
+func userAuthenticationMethodPhoneResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseUserPhoneMethodId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	method, status, err := client.GetPhoneMethod(ctx, id.UserId, id.MethodId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving phone authentication method %q for User with ID %q: %+v", id.MethodId, id.UserId, err)
+	}
+
+	d.Set("user_object_id", id.UserId)
+	d.Set("phone_number", method.PhoneNumber)
+	d.Set("type", method.PhoneType)
+
+	return nil
+}
+
+func userAuthenticationMethodPhoneResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.UsersClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseUserPhoneMethodId(d.Id())
+	if err != nil {
+		return fmt.Errorf("unable to parse ID: %v", err)
+	}
+
+	if _, err := client.DeletePhoneMethod(ctx, id.UserId, id.MethodId); err != nil {
+		return fmt.Errorf("removing phone authentication method %q for User with ID %q: %+v", id.MethodId, id.UserId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccUserAuthenticationMethodPhone_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_authentication_method_phone", "test")
+	pw := "utils@$$wR2" + acctest.RandStringFromCharSet(7, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckUserAuthenticationMethodPhoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAuthenticationMethodPhone_basic(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUserAuthenticationMethodPhoneExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "phone_number", "+1 2065551234"),
+					resource.TestCheckResourceAttr(data.ResourceName, "type", "mobile"),
+				),
+			},
+			data.ImportStep(),
+			{
+				Config: testAccUserAuthenticationMethodPhone_update(data.RandomInteger, pw),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUserAuthenticationMethodPhoneExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "phone_number", "+1 2065555678"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckUserAuthenticationMethodPhoneExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.UsersClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseUserPhoneMethodId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		if _, status, err := client.GetPhoneMethod(ctx, id.UserId, id.MethodId); err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("Phone Authentication Method %q does not exist for User %q", id.MethodId, id.UserId)
+			}
+			return fmt.Errorf("retrieving Phone Authentication Method %q for User %q: %+v", id.MethodId, id.UserId, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckUserAuthenticationMethodPhoneDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_user_authentication_method_phone" {
+			continue
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.UsersClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		id, err := graph.ParseUserPhoneMethodId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to parse ID: %v", err)
+		}
+
+		method, status, err := client.GetPhoneMethod(ctx, id.UserId, id.MethodId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Phone Authentication Method still exists:\n%#v", method)
+	}
+
+	return nil
+}
+
+func testAccUserAuthenticationMethodPhone_basic(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_user_authentication_method_phone" "test" {
+  user_object_id = azuread_user.test.id
+  phone_number   = "+1 2065551234"
+}
+`, id, password)
+}
+
+func testAccUserAuthenticationMethodPhone_update(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "tenant_domain" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.tenant_domain.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_user_authentication_method_phone" "test" {
+  user_object_id = azuread_user.test.id
+  phone_number   = "+1 2065555678"
+  type           = "mobile"
+}
+`, id, password)
+}
@@ -0,0 +1,289 @@
+package msgraph
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func userCustomSecurityAttributeAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: userCustomSecurityAttributeAssignmentResourceCreate,
+		Read:   userCustomSecurityAttributeAssignmentResourceRead,
+		Update: userCustomSecurityAttributeAssignmentResourceUpdate,
+		Delete: userCustomSecurityAttributeAssignmentResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := graph.ParseCustomSecurityAttributeAssignmentId(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"user_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"attribute_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"attribute_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "values"},
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"values": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "values"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+// expandCustomSecurityAttributeAssignment builds the customSecurityAttributes patch
+// payload for a single attribute, converting the configured string value(s) to the
+// type declared by the attribute definition.
+func expandCustomSecurityAttributeAssignment(d *schema.ResourceData, definition *graph.CustomSecurityAttributeDefinition) (map[string]interface{}, error) {
+	attributeSetName := d.Get("attribute_set_name").(string)
+	attributeName := d.Get("attribute_name").(string)
+
+	attributeType := "String"
+	if definition.Type != nil {
+		attributeType = *definition.Type
+	}
+
+	attribute := map[string]interface{}{
+		"@odata.type": "#Microsoft.DirectoryServices.CustomSecurityAttributeValue",
+	}
+
+	if definition.IsCollection != nil && *definition.IsCollection {
+		raw, ok := d.GetOk("values")
+		if !ok {
+			return nil, fmt.Errorf("attribute %q is multi-valued, so `values` must be used instead of `value`", attributeName)
+		}
+
+		values := make([]interface{}, 0)
+		for _, v := range raw.([]interface{}) {
+			value, err := customSecurityAttributeValueFromString(attributeType, v.(string))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+
+		attribute[attributeName] = values
+		if attributeType == "Integer" {
+			attribute[attributeName+"@odata.type"] = "#Collection(Int32)"
+		} else {
+			attribute[attributeName+"@odata.type"] = "#Collection(String)"
+		}
+	} else {
+		raw, ok := d.GetOk("value")
+		if !ok {
+			return nil, fmt.Errorf("attribute %q is single-valued, so `value` must be used instead of `values`", attributeName)
+		}
+
+		value, err := customSecurityAttributeValueFromString(attributeType, raw.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		attribute[attributeName] = value
+		if attributeType == "Integer" {
+			attribute[attributeName+"@odata.type"] = "#Int32"
+		}
+	}
+
+	return map[string]interface{}{
+		attributeSetName: attribute,
+	}, nil
+}
+
+func customSecurityAttributeValueFromString(attributeType, value string) (interface{}, error) {
+	switch attributeType {
+	case "Integer":
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer value for the attribute, got %q", value)
+		}
+		return i, nil
+	case "Boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected a boolean value for the attribute, got %q", value)
+		}
+		return b, nil
+	default:
+		return value, nil
+	}
+}
+
+func customSecurityAttributeValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func userCustomSecurityAttributeAssignmentResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	userId := d.Get("user_object_id").(string)
+	definitionId := fmt.Sprintf("%s_%s", d.Get("attribute_set_name").(string), d.Get("attribute_name").(string))
+
+	definition, _, err := client.GetDefinition(ctx, definitionId)
+	if err != nil {
+		return fmt.Errorf("retrieving Custom Security Attribute Definition %q: %+v", definitionId, err)
+	}
+
+	attributes, err := expandCustomSecurityAttributeAssignment(d, definition)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.SetUserAttributes(ctx, userId, attributes); err != nil {
+		return fmt.Errorf("assigning Custom Security Attribute %q for User %q: %+v", definitionId, userId, err)
+	}
+
+	d.SetId(graph.CustomSecurityAttributeAssignmentIdFrom(userId, definitionId).String())
+
+	return userCustomSecurityAttributeAssignmentResourceRead(d, meta)
+}
+
+func userCustomSecurityAttributeAssignmentResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseCustomSecurityAttributeAssignmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	definition, _, err := client.GetDefinition(ctx, id.DefinitionId)
+	if err != nil {
+		return fmt.Errorf("retrieving Custom Security Attribute Definition %q: %+v", id.DefinitionId, err)
+	}
+
+	attributes, err := expandCustomSecurityAttributeAssignment(d, definition)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.SetUserAttributes(ctx, id.ObjectId, attributes); err != nil {
+		return fmt.Errorf("updating Custom Security Attribute %q for User %q: %+v", id.DefinitionId, id.ObjectId, err)
+	}
+
+	return userCustomSecurityAttributeAssignmentResourceRead(d, meta)
+}
+
+func userCustomSecurityAttributeAssignmentResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseCustomSecurityAttributeAssignmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(id.DefinitionId, "_", 2)
+	attributeSetName, attributeName := parts[0], parts[1]
+
+	attributes, status, err := client.GetUserAttributes(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] User %q was not found - removing assignment from state", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving custom security attributes for User %q: %+v", id.ObjectId, err)
+	}
+
+	value, ok := attributes[attributeSetName][attributeName]
+	if !ok || value == nil {
+		log.Printf("[DEBUG] Custom Security Attribute %q is not assigned to User %q - removing from state", id.DefinitionId, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_object_id", id.ObjectId)
+	d.Set("attribute_set_name", attributeSetName)
+	d.Set("attribute_name", attributeName)
+
+	if values, ok := value.([]interface{}); ok {
+		flattened := make([]string, 0)
+		for _, v := range values {
+			flattened = append(flattened, customSecurityAttributeValueToString(v))
+		}
+		d.Set("value", "")
+		d.Set("values", flattened)
+	} else {
+		d.Set("value", customSecurityAttributeValueToString(value))
+		d.Set("values", []string{})
+	}
+
+	return nil
+}
+
+func userCustomSecurityAttributeAssignmentResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := meta.(*clients.AadClient).StopContext
+
+	id, err := graph.ParseCustomSecurityAttributeAssignmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(id.DefinitionId, "_", 2)
+	attributeSetName, attributeName := parts[0], parts[1]
+
+	// assigned values are removed by patching the attribute to null
+	attributes := map[string]interface{}{
+		attributeSetName: map[string]interface{}{
+			"@odata.type": "#Microsoft.DirectoryServices.CustomSecurityAttributeValue",
+			attributeName: nil,
+		},
+	}
+
+	status, err := client.SetUserAttributes(ctx, id.ObjectId, attributes)
+	if err != nil && status != http.StatusNotFound {
+		return fmt.Errorf("removing Custom Security Attribute %q from User %q: %+v", id.DefinitionId, id.ObjectId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/msgraph/graph"
+)
+
+func TestAccUserCustomSecurityAttributeAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_custom_security_attribute_assignment", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckUserCustomSecurityAttributeAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserCustomSecurityAttributeAssignment_basic(data.RandomInteger, data.RandomPassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUserCustomSecurityAttributeAssignmentExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "attribute_set_name", testAttributeSetName),
+					resource.TestCheckResourceAttr(data.ResourceName, "value", "alpha"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckUserCustomSecurityAttributeAssignmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %q", name)
+		}
+
+		id, err := graph.ParseCustomSecurityAttributeAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+		ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+		attributes, status, err := client.GetUserAttributes(ctx, id.ObjectId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return fmt.Errorf("User %q does not exist", id.ObjectId)
+			}
+			return fmt.Errorf("retrieving custom security attributes for User %q: %+v", id.ObjectId, err)
+		}
+
+		for _, values := range attributes {
+			for attributeName, value := range values {
+				if value != nil && fmt.Sprintf("%s_%s", testAttributeSetName, attributeName) == id.DefinitionId {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Custom Security Attribute %q is not assigned to User %q", id.DefinitionId, id.ObjectId)
+	}
+}
+
+func testCheckUserCustomSecurityAttributeAssignmentDestroy(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.AadClient).MsGraph.CustomSecurityAttributesClient
+	ctx := acceptance.AzureADProvider.Meta().(*clients.AadClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_user_custom_security_attribute_assignment" {
+			continue
+		}
+
+		id, err := graph.ParseCustomSecurityAttributeAssignmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		attributes, status, err := client.GetUserAttributes(ctx, id.ObjectId)
+		if err != nil {
+			if status == http.StatusNotFound {
+				// the user itself is gone along with its attribute values
+				continue
+			}
+			return fmt.Errorf("retrieving custom security attributes for User %q: %+v", id.ObjectId, err)
+		}
+
+		for _, values := range attributes {
+			for attributeName, value := range values {
+				if value != nil && fmt.Sprintf("%s_%s", testAttributeSetName, attributeName) == id.DefinitionId {
+					return fmt.Errorf("Custom Security Attribute %q is still assigned to User %q", id.DefinitionId, id.ObjectId)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccUserCustomSecurityAttributeAssignment_basic(id int, password string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set_name = "%[3]s"
+  name               = "acctestAttr%[1]d"
+  type               = "String"
+}
+
+resource "azuread_user_custom_security_attribute_assignment" "test" {
+  user_object_id     = azuread_user.test.object_id
+  attribute_set_name = azuread_custom_security_attribute_definition.test.attribute_set_name
+  attribute_name     = azuread_custom_security_attribute_definition.test.name
+  value              = "alpha"
+}
+`, id, password, testAttributeSetName)
+}
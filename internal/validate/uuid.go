@@ -7,7 +7,7 @@ import (
 	"github.com/hashicorp/go-uuid"
 )
 
-//uuid regex helper
+// uuid regex helper
 var UUIDRegExp = regexp.MustCompile("^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$")
 
 func UUID(i interface{}, k string) (warnings []string, errors []error) {